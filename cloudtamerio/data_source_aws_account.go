@@ -0,0 +1,166 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsAccountRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regex": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_type_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"include_linked_account_spend": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"linked_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"payer_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"skip_access_checking": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"start_datecode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	resp := new(hc.AwsAccountListResponse)
+	err := c.GET(ctx, "/v3/account?account-type=aws", resp)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	f := hc.NewFilterable(d)
+
+	arr := make([]map[string]interface{}, 0)
+	for _, item := range resp.Data {
+		data := make(map[string]interface{})
+		data["account_alias"] = item.AccountAlias
+		data["account_number"] = item.AccountNumber
+		data["account_type_id"] = item.AccountTypeID
+		data["created_at"] = item.CreatedAt
+		data["email"] = item.Email
+		data["id"] = item.ID
+		data["include_linked_account_spend"] = item.IncludeLinkedAccountSpend
+		data["linked_role"] = item.LinkedRole
+		data["name"] = item.Name
+		data["payer_id"] = item.PayerID
+		data["project_id"] = item.ProjectID
+		data["skip_access_checking"] = item.SkipAccessChecking
+		data["start_datecode"] = item.StartDatecode
+
+		match, err := f.Match(data)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to filter AWS Account",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "filter"),
+			})
+			return diags
+		} else if !match {
+			continue
+		}
+
+		arr = append(arr, data)
+	}
+
+	if err := d.Set("list", arr); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	// Always run.
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,159 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsAmi() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsAmiRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regex": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"aws_ami_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shared_accounts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"sync_deprecation": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sync_tags": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsAmiRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	resp := new(hc.AwsAmiListResponse)
+	err := c.GET(ctx, "/v3/ami", resp)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS AMI",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	f := hc.NewFilterable(d)
+
+	arr := make([]map[string]interface{}, 0)
+	for _, item := range resp.Data {
+		data := make(map[string]interface{})
+		data["account_id"] = item.AccountID
+		data["aws_ami_id"] = item.AwsAmiID
+		data["description"] = item.Description
+		data["expires_at"] = item.ExpiresAt
+		data["id"] = item.ID
+		data["name"] = item.Name
+		data["region"] = item.Region
+		data["shared_accounts"] = hc.InflateObjectWithID(item.SharedAccounts)
+		data["sync_deprecation"] = item.SyncDeprecation
+		data["sync_tags"] = item.SyncTags
+
+		match, err := f.Match(data)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to filter AWS AMI",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "filter"),
+			})
+			return diags
+		} else if !match {
+			continue
+		}
+
+		arr = append(arr, data)
+	}
+
+	if err := d.Set("list", arr); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS AMI",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	// Always run.
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -115,7 +115,7 @@ func dataSourceAwsCloudformationTemplateRead(ctx context.Context, d *schema.Reso
 	c := m.(*hc.Client)
 
 	resp := new(hc.CFTListResponseWithOwners)
-	err := c.GET("/v3/cft", resp)
+	err := c.GET(ctx, "/v3/cft", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -110,7 +110,7 @@ func dataSourceAwsIamPolicyRead(ctx context.Context, d *schema.ResourceData, m i
 	c := m.(*hc.Client)
 
 	resp := new(hc.IAMPolicyListResponse)
-	err := c.GET("/v3/iam-policy", resp)
+	err := c.GET(ctx, "/v3/iam-policy", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
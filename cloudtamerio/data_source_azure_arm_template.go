@@ -118,7 +118,7 @@ func dataSourceAzureArmTemplateRead(ctx context.Context, d *schema.ResourceData,
 	c := m.(*hc.Client)
 
 	resp := new(hc.AzureARMTemplateListResponse)
-	err := c.GET("/v3/azure-arm-template", resp)
+	err := c.GET(ctx, "/v3/azure-arm-template", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
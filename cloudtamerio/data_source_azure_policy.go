@@ -106,7 +106,7 @@ func dataSourceAzurePolicyRead(ctx context.Context, d *schema.ResourceData, m in
 	c := m.(*hc.Client)
 
 	resp := new(hc.AzurePolicyListResponse)
-	err := c.GET("/v3/azure-policy", resp)
+	err := c.GET(ctx, "/v3/azure-policy", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -102,7 +102,7 @@ func dataSourceAzureRoleRead(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*hc.Client)
 
 	resp := new(hc.AzureRoleListResponse)
-	err := c.GET("/v3/azure-role", resp)
+	err := c.GET(ctx, "/v3/azure-role", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
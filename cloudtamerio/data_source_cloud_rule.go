@@ -80,7 +80,7 @@ func dataSourceCloudRuleRead(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*hc.Client)
 
 	resp := new(hc.CloudRuleListResponse)
-	err := c.GET("/v3/cloud-rule", resp)
+	err := c.GET(ctx, "/v3/cloud-rule", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -125,7 +125,7 @@ func dataSourceComplianceCheckRead(ctx context.Context, d *schema.ResourceData,
 	c := m.(*hc.Client)
 
 	resp := new(hc.ComplianceCheckListResponse)
-	err := c.GET("/v3/compliance/check", resp)
+	err := c.GET(ctx, "/v3/compliance/check", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,43 @@
+package cloudtamerio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceComplianceFindingsRead(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"status":200,"data":[
+			{"id":1,"account_id":4,"compliance_check_id":2,"project_id":3,"region":"us-east-1","resource_name":"bucket-a","severity":"critical","severity_type_id":1},
+			{"id":2,"account_id":4,"compliance_check_id":2,"project_id":3,"region":"us-west-2","resource_name":"bucket-b","severity":"low","severity_type_id":4},
+			{"id":3,"account_id":5,"compliance_check_id":2,"project_id":6,"region":"us-east-1","resource_name":"bucket-c","severity":"critical","severity_type_id":1}
+		]}`))
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	// Find the critical findings on a single project. The project and the
+	// severity are sent to the API, and since this server ignores them they
+	// are still matched here.
+	d := schema.TestResourceDataRaw(t, dataSourceComplianceFindings().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "project_id", "values": []interface{}{"3"}},
+			map[string]interface{}{"name": "severity", "values": []interface{}{"critical"}},
+		},
+	})
+	assert.False(t, dataSourceComplianceFindingsRead(context.Background(), d, c).HasError())
+	assert.Equal(t, "project_id=3&severity=critical", query)
+	assert.Equal(t, 1, d.Get("list.#"))
+	assert.Equal(t, "bucket-a", d.Get("list.0.resource_name"))
+	assert.Equal(t, "us-east-1", d.Get("list.0.region"))
+}
@@ -78,7 +78,7 @@ func dataSourceComplianceStandardRead(ctx context.Context, d *schema.ResourceDat
 	c := m.(*hc.Client)
 
 	resp := new(hc.ComplianceStandardListResponse)
-	err := c.GET("/v3/compliance/standard", resp)
+	err := c.GET(ctx, "/v3/compliance/standard", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
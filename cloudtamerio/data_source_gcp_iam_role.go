@@ -111,7 +111,7 @@ func dataSourceGcpIamRoleRead(ctx context.Context, d *schema.ResourceData, m int
 	c := m.(*hc.Client)
 
 	resp := new(hc.GCPRoleListResponseWithOwners)
-	err := c.GET("/v3/gcp-iam-role", resp)
+	err := c.GET(ctx, "/v3/gcp-iam-role", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -54,6 +54,30 @@ func dataSourceOU() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"labels": {
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"color": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+							Type:     schema.TypeList,
+							Computed: true,
+						},
 						"name": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -78,7 +102,7 @@ func dataSourceOURead(ctx context.Context, d *schema.ResourceData, m interface{}
 	c := m.(*hc.Client)
 
 	resp := new(hc.OUListResponse)
-	err := c.GET("/v3/ou", resp)
+	err := c.GET(ctx, "/v3/ou", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -96,6 +120,7 @@ func dataSourceOURead(ctx context.Context, d *schema.ResourceData, m interface{}
 		data["created_at"] = item.CreatedAt
 		data["description"] = item.Description
 		data["id"] = item.ID
+		data["labels"] = inflateLabelList(item.Labels)
 		data["name"] = item.Name
 		data["parent_ou_id"] = item.ParentOuID
 		data["permission_scheme_id"] = item.PermissionSchemeID
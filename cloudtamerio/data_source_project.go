@@ -62,6 +62,30 @@ func dataSourceProject() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"labels": {
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"color": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+							Type:     schema.TypeList,
+							Computed: true,
+						},
 						"name": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -82,7 +106,7 @@ func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m interf
 	c := m.(*hc.Client)
 
 	resp := new(hc.ProjectListResponse)
-	err := c.GET("/v3/project", resp)
+	err := c.GET(ctx, "/v3/project", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -102,6 +126,7 @@ func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m interf
 		data["default_aws_region"] = item.DefaultAwsRegion
 		data["description"] = item.Description
 		data["id"] = item.ID
+		data["labels"] = inflateLabelList(item.Labels)
 		data["name"] = item.Name
 		data["ou_id"] = item.OUID
 
@@ -82,7 +82,7 @@ func dataSourceSamlGroupAssociationRead(ctx context.Context, d *schema.ResourceD
 	c := m.(*hc.Client)
 
 	resp := new(hc.GroupAssociationListResponse)
-	err := c.GET("/v3/idms/{id}/group-association", resp)
+	err := c.GET(ctx, "/v3/idms/{id}/group-association", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -106,7 +106,7 @@ func dataSourceService_control_policyRead(ctx context.Context, d *schema.Resourc
 	c := m.(*hc.Client)
 
 	resp := new(hc.ServiceControlPolicyListResponse)
-	err := c.GET("/v3/service-control-policy", resp)
+	err := c.GET(ctx, "/v3/service-control-policy", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,152 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceUser resolves a single user so the ID can be used in owner and
+// membership blocks.
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserRead,
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"email", "username"},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idms_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"last_login": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	username := d.Get("username").(string)
+	email := d.Get("email").(string)
+	idmsID, hasIdms := d.GetOk("idms_id")
+
+	resp := new(hc.UserListResponse)
+	err := c.GET(ctx, "/v3/user", resp)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), "all"),
+		})
+		return diags
+	}
+
+	matches := make([]map[string]interface{}, 0)
+	for _, item := range resp.Data {
+		// Usernames are unique within an IDMS, email addresses are compared
+		// without case.
+		if username != "" && item.Username != username {
+			continue
+		} else if email != "" && !strings.EqualFold(item.Email, email) {
+			continue
+		} else if hasIdms && item.IdmsID != idmsID.(int) {
+			continue
+		}
+
+		data := make(map[string]interface{})
+		data["created_at"] = item.CreatedAt
+		data["display_name"] = item.DisplayName
+		data["email"] = item.Email
+		data["enabled"] = item.Enabled
+		data["first_name"] = item.FirstName
+		data["id"] = item.ID
+		data["idms_id"] = item.IdmsID
+		data["last_login"] = item.LastLogin
+		data["last_name"] = item.LastName
+		data["phone"] = item.Phone
+		data["username"] = item.Username
+
+		matches = append(matches, data)
+	}
+
+	search := username
+	if search == "" {
+		search = email
+	}
+
+	if len(matches) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to find User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", "no user matches the search", search),
+		})
+		return diags
+	} else if len(matches) > 1 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to find User",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", "more than one user matches the search, set idms_id to narrow it", search),
+		})
+		return diags
+	}
+
+	data := matches[0]
+	d.SetId(strconv.Itoa(data["id"].(int)))
+	delete(data, "id")
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set User",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), d.Id()),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -78,7 +78,7 @@ func dataSourceUserGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*hc.Client)
 
 	resp := new(hc.UGroupListResponse)
-	err := c.GET("/v3/user-group", resp)
+	err := c.GET(ctx, "/v3/user-group", resp)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
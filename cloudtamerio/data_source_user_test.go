@@ -0,0 +1,54 @@
+package cloudtamerio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceUserRead(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":200,"data":[
+			{"id":1,"username":"admin","email":"admin@example.com","idms_id":1,"enabled":true},
+			{"id":2,"username":"alice","email":"Alice@example.com","idms_id":1,"enabled":true},
+			{"id":3,"username":"alice","email":"alice@example.com","idms_id":2,"enabled":true}
+		]}`))
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	read := func(raw map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSourceUser().Schema, raw)
+		diags := dataSourceUserRead(context.Background(), d, c)
+		if diags.HasError() {
+			return nil
+		}
+		return d
+	}
+
+	// By username
+	d := read(map[string]interface{}{"username": "admin"})
+	if assert.NotNil(t, d) {
+		assert.Equal(t, "1", d.Id())
+		assert.Equal(t, "admin@example.com", d.Get("email"))
+	}
+
+	// By email without case, narrowed by IDMS
+	d = read(map[string]interface{}{"email": "ALICE@example.com", "idms_id": 2})
+	if assert.NotNil(t, d) {
+		assert.Equal(t, "3", d.Id())
+	}
+
+	// More than one match
+	assert.Nil(t, read(map[string]interface{}{"username": "alice"}))
+
+	// No match
+	assert.Nil(t, read(map[string]interface{}{"username": "bob"}))
+}
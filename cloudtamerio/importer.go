@@ -0,0 +1,49 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importWithRead returns an importer that reads the item into state. An error
+// is returned if the read fails or the item does not exist.
+func importWithRead(resourceType string, read schema.ReadContextFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			ID := d.Id()
+
+			diags := read(ctx, d, m)
+			if diags.HasError() {
+				return nil, diagsToError(diags)
+			}
+
+			// The read removes the ID when the item is not found.
+			if d.Id() == "" {
+				return nil, fmt.Errorf("cannot import %s with ID %s: it does not exist", resourceType, ID)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// diagsToError combines the error diagnostics into a single error.
+func diagsToError(diags diag.Diagnostics) error {
+	arr := make([]string, 0)
+	for _, v := range diags {
+		if v.Severity != diag.Error {
+			continue
+		}
+		if v.Detail != "" {
+			arr = append(arr, v.Summary+": "+v.Detail)
+		} else {
+			arr = append(arr, v.Summary)
+		}
+	}
+
+	return fmt.Errorf("%s", strings.Join(arr, "\n"))
+}
@@ -0,0 +1,41 @@
+package cloudtamerio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportWithRead(t *testing.T) {
+	newData := func() *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId("5")
+		return d
+	}
+
+	// Found
+	importer := importWithRead("cloudtamerio_ou", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return nil
+	})
+	arr, err := importer.StateContext(context.Background(), newData(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, arr, 1)
+
+	// Not found
+	importer = importWithRead("cloudtamerio_ou", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		d.SetId("")
+		return nil
+	})
+	_, err = importer.StateContext(context.Background(), newData(), nil)
+	assert.EqualError(t, err, "cannot import cloudtamerio_ou with ID 5: it does not exist")
+
+	// Read error
+	importer = importWithRead("cloudtamerio_ou", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: "Unable to read OU", Detail: "Error: forbidden"}}
+	})
+	_, err = importer.StateContext(context.Background(), newData(), nil)
+	assert.EqualError(t, err, "Unable to read OU: Error: forbidden")
+}
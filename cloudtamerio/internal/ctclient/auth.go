@@ -0,0 +1,189 @@
+package ctclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryBuffer is how long before a login token expires that a new one
+// is requested.
+const tokenExpiryBuffer = time.Minute
+
+// TokenSource provides the bearer token sent with each request.
+type TokenSource interface {
+	// Token returns the bearer token. If refresh is true, the last token was
+	// rejected by cloudtamer.io and a new one should be fetched.
+	Token(ctx context.Context, refresh bool) (string, error)
+}
+
+// FileTokenSource reads the API key from a file. The file is read again when
+// the key is rejected so it can be rotated without restarting Terraform.
+type FileTokenSource struct {
+	Path string
+
+	mu    sync.Mutex
+	token string
+}
+
+// Token -
+func (s *FileTokenSource) Token(ctx context.Context, refresh bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && !refresh {
+		return s.token, nil
+	}
+
+	b, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		return "", fmt.Errorf("could not read the API key file: %v", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("the API key file is empty: %s", s.Path)
+	}
+	s.token = token
+
+	return s.token, nil
+}
+
+// CommandTokenSource runs an external credential helper and uses its output
+// as the API key. The command is run again when the key is rejected.
+type CommandTokenSource struct {
+	Command string
+
+	mu    sync.Mutex
+	token string
+}
+
+// Token -
+func (s *CommandTokenSource) Token(ctx context.Context, refresh bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && !refresh {
+		return s.token, nil
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", s.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", s.Command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("the API key command failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", errors.New("the API key command did not output a key")
+	}
+	s.token = token
+
+	return s.token, nil
+}
+
+// Login holds the credentials exchanged for a short-lived bearer token.
+// Either Password or SAMLToken must be set.
+type Login struct {
+	IdmsID    int
+	Username  string
+	Password  string
+	SAMLToken string
+}
+
+// LoginTokenSource exchanges a username and password or a SAML token for a
+// short-lived bearer token. The login is repeated when the token expires or
+// is rejected.
+type LoginTokenSource struct {
+	client *Client
+	login  Login
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewLoginTokenSource returns a token source that logs in to the
+// cloudtamer.io installation used by the client.
+func NewLoginTokenSource(c *Client, login Login) *LoginTokenSource {
+	return &LoginTokenSource{
+		client: c,
+		login:  login,
+	}
+}
+
+// Token -
+func (s *LoginTokenSource) Token(ctx context.Context, refresh bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && !refresh && (s.expiry.IsZero() || time.Now().Add(tokenExpiryBuffer).Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	var urlPath string
+	var sendData interface{}
+	if s.login.SAMLToken != "" {
+		urlPath = "/v3/login/saml"
+		sendData = LoginSAMLCreate{
+			IdmsID:    s.login.IdmsID,
+			SAMLToken: s.login.SAMLToken,
+		}
+	} else {
+		urlPath = "/v3/login"
+		sendData = LoginCreate{
+			IdmsID:   s.login.IdmsID,
+			Password: s.login.Password,
+			Username: s.login.Username,
+		}
+	}
+
+	rb, err := json.Marshal(sendData)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", s.client.HostURL, urlPath), bytes.NewReader(rb))
+	if err != nil {
+		return "", err
+	}
+
+	// Send the login through the client so it shares the rate limit, the
+	// limit on concurrent requests, the retries, and the logging with every
+	// other request. It is sent without a token so a rejected login is not
+	// retried with the token it is trying to get.
+	body, _, err := s.client.send(req, false)
+	if err != nil {
+		return "", fmt.Errorf("unable to log in: %w", err)
+	}
+
+	resp := LoginResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Data.Access.Token == "" {
+		return "", errors.New("unable to log in: the response did not include an access token")
+	}
+
+	s.token = resp.Data.Access.Token
+	s.expiry = time.Time{}
+	if t, err := time.Parse(time.RFC3339, resp.Data.Access.Expiry); err == nil {
+		s.expiry = t
+	}
+
+	return s.token, nil
+}
@@ -0,0 +1,157 @@
+package ctclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTokenSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ctclient")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "apikey")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("app_1_first\n"), 0600))
+
+	s := &FileTokenSource{Path: path}
+	token, err := s.Token(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, "app_1_first", token)
+
+	// The cached key is used until it is rejected.
+	assert.NoError(t, ioutil.WriteFile(path, []byte("app_1_second"), 0600))
+	token, _ = s.Token(context.Background(), false)
+	assert.Equal(t, "app_1_first", token)
+	token, _ = s.Token(context.Background(), true)
+	assert.Equal(t, "app_1_second", token)
+
+	_, err = (&FileTokenSource{Path: filepath.Join(dir, "missing")}).Token(context.Background(), false)
+	assert.Error(t, err)
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	token, err := (&CommandTokenSource{Command: "echo app_1_fromcommand"}).Token(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, "app_1_fromcommand", token)
+
+	_, err = (&CommandTokenSource{Command: "echo oops >&2; exit 1"}).Token(context.Background(), false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "oops")
+
+	_, err = (&CommandTokenSource{Command: "true"}).Token(context.Background(), false)
+	assert.Error(t, err)
+}
+
+// newLoginServer returns a server that issues a new token on each login and
+// only accepts the most recent token.
+func newLoginServer(t *testing.T, logins *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := fmt.Sprintf("token-%d", atomic.LoadInt32(logins))
+
+		switch r.URL.Path {
+		case "/api/v3/login":
+			var login LoginCreate
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&login))
+			if login.Username != "admin" || login.Password != "secret" || login.IdmsID != 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"status":401,"message":"Invalid credentials."}`))
+				return
+			}
+			n := atomic.AddInt32(logins, 1)
+			fmt.Fprintf(w, `{"status":200,"data":{"user_id":1,"access":{"token":"token-%d","expiry":"2099-01-01T00:00:00Z"}}}`, n)
+		default:
+			if r.Header.Get("Authorization") != "Bearer "+current {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"status":200}`))
+		}
+	}))
+}
+
+func TestLoginTokenSource(t *testing.T) {
+	var logins int32
+	ts := newLoginServer(t, &logins)
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.TokenSource = NewLoginTokenSource(c, Login{IdmsID: 1, Username: "admin", Password: "secret"})
+
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+
+	// Invalidate the token on the server so the client has to log in again.
+	atomic.AddInt32(&logins, 1)
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&logins))
+}
+
+func TestLoginTokenSourceInvalidCredentials(t *testing.T) {
+	var logins int32
+	ts := newLoginServer(t, &logins)
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.TokenSource = NewLoginTokenSource(c, Login{IdmsID: 1, Username: "admin", Password: "wrong"})
+
+	err := c.GET(context.Background(), "/v3/ou", nil)
+	assert.Error(t, err)
+	assert.True(t, IsUnauthorized(err))
+	assert.Contains(t, err.Error(), "Invalid credentials.")
+}
+
+func TestLoginTokenSourceUsesClientLimits(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"status":200,"data":{"user_id":1,"access":{"token":"token-1","expiry":"2099-01-01T00:00:00Z"}}}`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.SetRateLimit(20, 1)
+	s := NewLoginTokenSource(c, Login{IdmsID: 1, Username: "admin", Password: "secret"})
+
+	// The login is retried and the retry waits for the rate limit like any
+	// other request.
+	start := time.Now()
+	token, err := s.Token(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.True(t, time.Since(start) >= 40*time.Millisecond, "expected the login to wait for the rate limit")
+}
+
+func TestStaticTokenIsNotRefreshed(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	err := c.GET(context.Background(), "/v3/ou", nil)
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
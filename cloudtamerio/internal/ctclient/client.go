@@ -3,17 +3,31 @@
 package ctclient
 
 import (
+	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried.
+	DefaultMaxRetries = 3
+	// DefaultRetryMinWait is the wait before the first retry.
+	DefaultRetryMinWait = 1 * time.Second
+	// DefaultRetryMaxWait is the longest backoff between two retries.
+	DefaultRetryMaxWait = 30 * time.Second
 )
 
 // Client -
@@ -21,55 +35,313 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	// TokenSource provides the bearer token if set, otherwise Token is used.
+	TokenSource TokenSource
+
+	// MaxRetries is the number of retries after the first attempt. Set to 0 to
+	// disable retries.
+	MaxRetries int
+	// RetryMinWait and RetryMaxWait bound the exponential backoff between
+	// retries.
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
+
+	limiter  *rateLimiter
+	inFlight semaphore
+}
+
+// TransportConfig holds the TLS and proxy settings for NewClient.
+type TransportConfig struct {
+	// SkipSSLValidation disables verification of the server certificate.
+	SkipSSLValidation bool
+	// CACertPEM holds PEM-encoded CA certificates that are trusted in addition
+	// to the system certificates.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM hold a PEM-encoded certificate and key
+	// for mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// ProxyURL overrides the proxy from the HTTP_PROXY and HTTPS_PROXY
+	// environment variables.
+	ProxyURL string
 }
 
 // NewClient .
-func NewClient(ctURL string, ctAPIKey string, skipSSLValidation bool) *Client {
+func NewClient(ctURL string, ctAPIKey string, tc TransportConfig) (*Client, error) {
+	tlsConfig, err := newTLSConfig(tc)
+	if err != nil {
+		return nil, err
+	}
 	customTransport := http.DefaultTransport.(*http.Transport).Clone()
-	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: skipSSLValidation}
+	customTransport.TLSClientConfig = tlsConfig
+
+	if tc.ProxyURL != "" {
+		proxyURL, err := url.Parse(tc.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("the proxy URL is not valid: %s", tc.ProxyURL)
+		}
+		customTransport.Proxy = http.ProxyURL(proxyURL)
+	}
 
 	c := Client{
 		HTTPClient: &http.Client{
 			Transport: customTransport,
 		},
+		MaxRetries:   DefaultMaxRetries,
+		RetryMinWait: DefaultRetryMinWait,
+		RetryMaxWait: DefaultRetryMaxWait,
 	}
 
 	// Append '/api' to the URL.
 	u, err := url.Parse(ctURL)
 	if err != nil {
-		log.Fatalln("The URL is not valid:", ctURL, err.Error())
+		return nil, fmt.Errorf("the URL is not valid: %v", err)
+	} else if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("the URL is not valid, it must include the scheme and host: %s", ctURL)
 	}
 	u.Path = path.Join(u.Path, "api")
 	c.HostURL = u.String()
 
 	c.Token = ctAPIKey
 
-	return &c
+	return &c, nil
+}
+
+// newTLSConfig builds the TLS configuration for the transport.
+func newTLSConfig(tc TransportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: tc.SkipSSLValidation}
+
+	if tc.CACertPEM != "" {
+		// Start with the system certificates so public CAs are still trusted.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(tc.CACertPEM)) {
+			return nil, errors.New("no valid certificates found in the CA certificate PEM")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if tc.ClientCertPEM != "" || tc.ClientKeyPEM != "" {
+		if tc.ClientCertPEM == "" || tc.ClientKeyPEM == "" {
+			return nil, errors.New("both a client certificate and a client key are required for mutual TLS")
+		}
+		cert, err := tls.X509KeyPair([]byte(tc.ClientCertPEM), []byte(tc.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("could not load the client certificate and key: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
 }
 
 func (c *Client) doRequest(req *http.Request) ([]byte, int, error) {
-	req.Header.Set("Authorization", "Bearer "+c.Token)
+	token, err := c.token(req.Context(), false)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return c.send(req, c.TokenSource != nil)
+}
+
+// send sends the request through the rate limit and the limit on concurrent
+// requests, and retries it if it fails. If refresh is true, a rejected token
+// is replaced with a new one from the TokenSource once.
+func (c *Client) send(req *http.Request, refresh bool) ([]byte, int, error) {
+	req.Header.Set(requestIDHeader, newRequestID())
+
+	refreshed := !refresh
+	for attempt, sent := 0, false; ; sent = true {
+		// Rewind the body so each attempt sends the full payload.
+		if sent && req.GetBody != nil {
+			b, err := req.GetBody()
+			if err != nil {
+				return nil, 0, err
+			}
+			req.Body = b
+		}
 
+		body, statusCode, retryAfter, err := c.doAttempt(req)
+		if err == nil {
+			return body, statusCode, nil
+		}
+
+		// A rejected token may have expired so get a new one and try again.
+		// This doesn't count as a retry.
+		if statusCode == http.StatusUnauthorized && !refreshed {
+			refreshed = true
+			token, tokenErr := c.token(req.Context(), true)
+			if tokenErr != nil {
+				return nil, statusCode, fmt.Errorf("%w (unable to refresh token: %v)", err, tokenErr)
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+			continue
+		}
+
+		if attempt >= c.MaxRetries || !shouldRetry(req.Method, statusCode) {
+			return body, statusCode, err
+		}
+
+		wait := c.backoff(attempt, retryAfter)
+		attempt++
+		log.Printf("[DEBUG] cloudtamer.io request failed, retrying in %v (attempt %d of %d): %v", wait, attempt, c.MaxRetries, redactString(err.Error()))
+		if ctxErr := sleepContext(req.Context(), wait); ctxErr != nil {
+			return nil, statusCode, fmt.Errorf("%w (stopped retrying: %v)", err, ctxErr)
+		}
+	}
+}
+
+// token returns the bearer token from the TokenSource or the static Token.
+func (c *Client) token(ctx context.Context, refresh bool) (string, error) {
+	if c.TokenSource == nil {
+		return c.Token, nil
+	}
+
+	return c.TokenSource.Token(ctx, refresh)
+}
+
+// SetRateLimit limits requests to rate per second with bursts of up to burst
+// requests. The limit is shared across all resources using the client. A
+// rate of 0 removes the limit.
+func (c *Client) SetRateLimit(rate float64, burst int) {
+	c.limiter = newRateLimiter(rate, burst)
+}
+
+// SetMaxConcurrentRequests limits the number of requests that can be in
+// flight at the same time. A value of 0 removes the limit.
+func (c *Client) SetMaxConcurrentRequests(n int) {
+	c.inFlight = newSemaphore(n)
+}
+
+// doAttempt sends a single request and returns the body, the status code,
+// the value of a Retry-After header, and an error if the request failed.
+func (c *Client) doAttempt(req *http.Request) ([]byte, int, time.Duration, error) {
+	if err := c.limiter.Wait(req.Context()); err != nil {
+		return nil, 0, 0, err
+	}
+
+	if err := c.inFlight.Acquire(req.Context()); err != nil {
+		return nil, 0, 0, err
+	}
+	defer c.inFlight.Release()
+
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, 0, err
+		logRequest(req, nil, nil, time.Since(start), err)
+		return nil, 0, 0, err
 	}
 	defer res.Body.Close()
 
 	body, err := ioutil.ReadAll(res.Body)
+	logRequest(req, res, body, time.Since(start), err)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, 0, err
 	}
 
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		return nil, res.StatusCode, fmt.Errorf("url: %s, method: %s, status: %d, body: %s", req.URL.String(), req.Method, res.StatusCode, body)
+		return nil, res.StatusCode, parseRetryAfter(res.Header.Get("Retry-After")), newAPIError(req, res.StatusCode, body)
+	}
+
+	return body, res.StatusCode, 0, nil
+}
+
+// shouldRetry determines if a failed request can be sent again. A status
+// code of 0 means the request never received a response (ex. connection
+// reset). Requests rejected with 429 or 503 were not processed so they are
+// safe to retry for every method. Everything else is only retried for
+// idempotent methods.
+func shouldRetry(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case 0, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(method)
 	}
 
-	return body, res.StatusCode, err
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// backoff returns the wait before the next attempt. The Retry-After value
+// from the server is used if set, otherwise the wait doubles on each attempt
+// and has jitter applied. Either way it is capped at RetryMaxWait so a large
+// Retry-After doesn't stall the apply.
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if retryAfter > c.RetryMaxWait {
+			return c.RetryMaxWait
+		}
+		return retryAfter
+	}
+
+	wait := c.RetryMinWait
+	for i := 0; i < attempt && wait < c.RetryMaxWait; i++ {
+		wait *= 2
+	}
+	if wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+
+	// Use half of the wait plus a random amount up to the other half so
+	// parallel requests don't retry at the same time.
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// sleepContext waits for the duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses a Retry-After header which is either a number of
+// seconds or an HTTP date. It returns 0 if the value is empty or invalid.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
 }
 
 // GET - Returns an element from CT.
-func (c *Client) GET(urlPath string, returnData interface{}) error {
+func (c *Client) GET(ctx context.Context, urlPath string, returnData interface{}) error {
 	if returnData != nil {
 		// Ensure the correct returnData was passed in.
 		v := reflect.ValueOf(returnData)
@@ -78,7 +350,7 @@ func (c *Client) GET(urlPath string, returnData interface{}) error {
 		}
 	}
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.HostURL, urlPath), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s", c.HostURL, urlPath), nil)
 	if err != nil {
 		return err
 	}
@@ -99,45 +371,64 @@ func (c *Client) GET(urlPath string, returnData interface{}) error {
 }
 
 // POST - creates an element in CT.
-func (c *Client) POST(urlPath string, sendData interface{}) (*Creation, error) {
+func (c *Client) POST(ctx context.Context, urlPath string, sendData interface{}) (*Creation, error) {
 	//return nil, fmt.Errorf("test error: %v %v %#v", c.HostURL, urlPath, sendData)
-	rb, err := json.Marshal(sendData)
+	data := Creation{}
+	err := c.POSTWithResponse(ctx, urlPath, sendData, &data)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.HostURL, urlPath), strings.NewReader(string(rb)))
+	// We allow 200 on POST when updating owners so we can't use this logic.
+	// if statusCode != http.StatusCreated {
+	// 	return &data, fmt.Errorf("received status code: %v | %v", statusCode, string(body))
+	// }
+
+	return &data, nil
+}
+
+// POSTWithResponse - creates an element in CT and decodes the whole response
+// into returnData for items that return more than the record ID.
+func (c *Client) POSTWithResponse(ctx context.Context, urlPath string, sendData interface{}, returnData interface{}) error {
+	// Ensure the correct returnData was passed in.
+	v := reflect.ValueOf(returnData)
+	if v.Kind() != reflect.Ptr {
+		return errors.New("data must pass a pointer, not a value")
+	}
+
+	rb, err := json.Marshal(sendData)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	body, _, err := c.doRequest(req)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s%s", c.HostURL, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	data := Creation{}
-	err = json.Unmarshal(body, &data)
+	body, _, err := c.doRequest(req)
 	if err != nil {
-		return nil, fmt.Errorf("could not unmarshal response body: %v", string(body))
+		return err
 	}
 
-	// We allow 200 on POST when updating owners so we can't use this logic.
-	// if statusCode != http.StatusCreated {
-	// 	return &data, fmt.Errorf("received status code: %v | %v", statusCode, string(body))
-	// }
+	// The body isn't included in the error since these responses can hold
+	// secrets that are only returned once, like app API keys.
+	err = json.Unmarshal(body, returnData)
+	if err != nil {
+		return fmt.Errorf("could not unmarshal response body: %v", err)
+	}
 
-	return &data, nil
+	return nil
 }
 
 // PATCH - updates an element in CT.
-func (c *Client) PATCH(urlPath string, sendData interface{}) error {
+func (c *Client) PATCH(ctx context.Context, urlPath string, sendData interface{}) error {
 	rb, err := json.Marshal(sendData)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s%s", c.HostURL, urlPath), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s%s", c.HostURL, urlPath), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -151,7 +442,7 @@ func (c *Client) PATCH(urlPath string, sendData interface{}) error {
 }
 
 // DELETE - removes an element from CT. sendData can be nil.
-func (c *Client) DELETE(urlPath string, sendData interface{}) error {
+func (c *Client) DELETE(ctx context.Context, urlPath string, sendData interface{}) error {
 	var req *http.Request
 	var err error
 
@@ -161,12 +452,12 @@ func (c *Client) DELETE(urlPath string, sendData interface{}) error {
 			return err
 		}
 
-		req, err = http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.HostURL, urlPath), strings.NewReader(string(rb)))
+		req, err = http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.HostURL, urlPath), strings.NewReader(string(rb)))
 		if err != nil {
 			return err
 		}
 	} else {
-		req, err = http.NewRequest("DELETE", fmt.Sprintf("%s%s", c.HostURL, urlPath), nil)
+		req, err = http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s%s", c.HostURL, urlPath), nil)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,224 @@
+package ctclient
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client pointed at the test server with short waits
+// so retries don't slow down the tests.
+func newTestClient(ts *httptest.Server) *Client {
+	c, err := NewClient(ts.URL, "app_1_test", TransportConfig{})
+	if err != nil {
+		panic(err)
+	}
+	c.RetryMinWait = time.Millisecond
+	c.RetryMaxWait = 5 * time.Millisecond
+	return c
+}
+
+func TestRetrySucceedsAfterUnavailable(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"status":200}`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	err := c.GET(context.Background(), "/v3/ou", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.MaxRetries = 2
+	err := c.GET(context.Background(), "/v3/ou", nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryDisabled(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.MaxRetries = 0
+	err := c.GET(context.Background(), "/v3/ou", nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPostOnlyForSafeStatusCodes(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	// A 502 on a POST may have created the item so it must not be retried.
+	c := newTestClient(ts)
+	_, err := c.POST(context.Background(), "/v3/ou", map[string]string{"name": "test"})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPostResendsBody(t *testing.T) {
+	var calls int32
+	bodies := make(chan string, 2)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies <- string(b)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"record_id":5,"status":201}`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	resp, err := c.POST(context.Background(), "/v3/ou", map[string]string{"name": "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, resp.RecordID)
+	assert.Equal(t, `{"name":"test"}`, <-bodies)
+	assert.Equal(t, `{"name":"test"}`, <-bodies)
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"status":200}`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.RetryMaxWait = 2 * time.Second
+	start := time.Now()
+	err := c.GET(context.Background(), "/v3/ou", nil)
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) >= time.Second, "expected the client to wait for the Retry-After header")
+}
+
+func TestRetryNotFoundIsNotRetried(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	err := c.GET(context.Background(), "/v3/ou/1", nil)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestBackoff(t *testing.T) {
+	c := &Client{
+		RetryMinWait: 100 * time.Millisecond,
+		RetryMaxWait: time.Second,
+	}
+
+	for attempt, max := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	} {
+		wait := c.backoff(attempt, 0)
+		assert.True(t, wait >= max/2 && wait <= max, "attempt %d: wait %v not in [%v, %v]", attempt, wait, max/2, max)
+	}
+
+	// The Retry-After value is used up to RetryMaxWait.
+	assert.Equal(t, 500*time.Millisecond, c.backoff(0, 500*time.Millisecond))
+	assert.Equal(t, time.Second, c.backoff(0, time.Hour))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("invalid"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1"))
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5"))
+
+	d := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, d > 50*time.Second && d <= time.Minute)
+	assert.Equal(t, time.Duration(0), parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)))
+}
+
+func TestRetryStopsWhenContextIsCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.RetryMinWait = time.Minute
+	c.RetryMaxWait = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.GET(ctx, "/v3/ou", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	assert.True(t, time.Since(start) < 10*time.Second, "expected the client to stop retrying when the context is done")
+}
+
+func TestNewClientInvalidURL(t *testing.T) {
+	_, err := NewClient("cloudtamerio.example.com", "app_1_test", TransportConfig{})
+	assert.Error(t, err)
+
+	_, err = NewClient("https://cloudtamerio.example.com/%zz", "app_1_test", TransportConfig{})
+	assert.Error(t, err)
+
+	c, err := NewClient("https://cloudtamerio.example.com", "app_1_test", TransportConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cloudtamerio.example.com/api", c.HostURL)
+}
+
+func TestPostWithResponseDecodeErrorHidesBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"status":201,"record":{"id":4,"key":"app_4_onetimesecret"`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	var resp map[string]interface{}
+	err := c.POSTWithResponse(context.Background(), "/v3/app-api-key", map[string]string{"name": "ci"}, &resp)
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "onetimesecret")
+}
@@ -0,0 +1,106 @@
+package ctclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when cloudtamer.io responds with a status code other
+// than 200 or 201.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Message is the error message parsed from the response body. It is empty
+	// if the body did not contain one.
+	Message string
+	// Body is the raw response body.
+	Body string
+}
+
+// Error -
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Body
+	}
+
+	return fmt.Sprintf("url: %s, method: %s, status: %d, message: %s", e.URL, e.Method, e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError and parses the message out of the body.
+func newAPIError(req *http.Request, statusCode int, body []byte) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Message:    parseErrorMessage(body),
+		Body:       string(body),
+	}
+}
+
+// parseErrorMessage returns the message from a cloudtamer.io error response
+// like: {"status": 400, "message": "..."}. Some endpoints return a list of
+// validation errors instead so those are joined together.
+func parseErrorMessage(body []byte) string {
+	var resp struct {
+		Message string   `json:"message"`
+		Error   string   `json:"error"`
+		Errors  []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return ""
+	}
+
+	switch {
+	case resp.Message != "":
+		return resp.Message
+	case resp.Error != "":
+		return resp.Error
+	case len(resp.Errors) > 0:
+		return strings.Join(resp.Errors, "; ")
+	}
+
+	return ""
+}
+
+// StatusCode returns the status code of an APIError or 0 if the error is not
+// an APIError.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	return 0
+}
+
+// IsNotFound returns true if the item requested does not exist.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized returns true if the API key is missing, invalid, or expired.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden returns true if the API key does not have permission to perform
+// the request.
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+// IsConflict returns true if the request conflicts with an existing item.
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsValidationError returns true if cloudtamer.io rejected the data sent.
+func IsValidationError(err error) bool {
+	code := StatusCode(err)
+	return code == http.StatusBadRequest || code == http.StatusUnprocessableEntity
+}
@@ -0,0 +1,65 @@
+package ctclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":404,"message":"OU not found."}`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	err := c.GET(context.Background(), "/v3/ou/5", nil)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "GET", apiErr.Method)
+	assert.Equal(t, ts.URL+"/api/v3/ou/5", apiErr.URL)
+	assert.Equal(t, "OU not found.", apiErr.Message)
+	assert.Contains(t, err.Error(), "message: OU not found.")
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsForbidden(err))
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	newErr := func(code int) error {
+		return &APIError{StatusCode: code}
+	}
+
+	assert.True(t, IsNotFound(newErr(http.StatusNotFound)))
+	assert.True(t, IsUnauthorized(newErr(http.StatusUnauthorized)))
+	assert.True(t, IsForbidden(newErr(http.StatusForbidden)))
+	assert.True(t, IsConflict(newErr(http.StatusConflict)))
+	assert.True(t, IsValidationError(newErr(http.StatusBadRequest)))
+	assert.True(t, IsValidationError(newErr(http.StatusUnprocessableEntity)))
+
+	// Wrapped errors are still detected.
+	assert.True(t, IsNotFound(fmt.Errorf("wrapped: %w", newErr(http.StatusNotFound))))
+
+	assert.False(t, IsNotFound(errors.New("status: 404")))
+	assert.False(t, IsNotFound(nil))
+	assert.Equal(t, 0, StatusCode(nil))
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	assert.Equal(t, "bad", parseErrorMessage([]byte(`{"status":400,"message":"bad"}`)))
+	assert.Equal(t, "bad", parseErrorMessage([]byte(`{"error":"bad"}`)))
+	assert.Equal(t, "a; b", parseErrorMessage([]byte(`{"errors":["a","b"]}`)))
+	assert.Equal(t, "", parseErrorMessage([]byte(`<html>Bad Gateway</html>`)))
+	assert.Equal(t, "", parseErrorMessage(nil))
+
+	// The raw body is used when there is no message.
+	e := &APIError{StatusCode: 502, Method: "GET", URL: "https://ct/api", Body: "Bad Gateway"}
+	assert.Equal(t, "url: https://ct/api, method: GET, status: 502, message: Bad Gateway", e.Error())
+}
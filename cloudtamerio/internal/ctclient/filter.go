@@ -3,6 +3,7 @@ package ctclient
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
@@ -55,8 +56,15 @@ func (f *Filterable) Match(m map[string]interface{}) (bool, error) {
 		return true, nil
 	}
 
+	// Filters on different fields of the same list must match the same item
+	// in the list so 'labels.key' and 'labels.value' match a single label.
+	grouped := f.groupByList()
+
 	// Loop through each filter.
 	for _, filter := range f.arr {
+		if _, ok := grouped[filter.keys[0]]; ok {
+			continue
+		}
 		found := false
 		for _, filterValue := range filter.values {
 			match, err := filter.DeepMatch(filter.keys, m, filterValue)
@@ -72,9 +80,120 @@ func (f *Filterable) Match(m map[string]interface{}) (bool, error) {
 		}
 	}
 
+	for name, filters := range grouped {
+		match, err := matchListItem(name, filters, m)
+		if err != nil {
+			return false, err
+		} else if !match {
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
+// groupByList returns the filters on a list, like 'labels.key', by the name
+// of the list. Only lists with filters on more than one field are returned.
+// Filters that repeat a field, like two 'owner_users.id' filters, match any
+// item in the list each so they are left out.
+func (f *Filterable) groupByList() map[string][]Filter {
+	byList := make(map[string][]Filter)
+	for _, filter := range f.arr {
+		if len(filter.keys) > 1 {
+			byList[filter.keys[0]] = append(byList[filter.keys[0]], filter)
+		}
+	}
+
+	grouped := make(map[string][]Filter)
+	for name, filters := range byList {
+		if len(filters) < 2 {
+			continue
+		}
+		keys := make(map[string]bool)
+		for _, filter := range filters {
+			keys[filter.key] = true
+		}
+		if len(keys) == len(filters) {
+			grouped[name] = filters
+		}
+	}
+
+	return grouped
+}
+
+// matchListItem returns true if one item in the list matches every filter.
+func matchListItem(name string, filters []Filter, m map[string]interface{}) (bool, error) {
+	val, ok := m[name]
+	if !ok {
+		return false, errors.New("filter is not found: " + name + fmt.Sprintf(" | %#v", m))
+	}
+
+	x, ok := val.([]interface{})
+	if !ok {
+		return false, nil
+	}
+
+	for _, i := range x {
+		vmap := i.(map[string]interface{})
+
+		all := true
+		for _, filter := range filters {
+			found := false
+			for _, filterValue := range filter.values {
+				match, err := filter.DeepMatch(filter.keys[1:], vmap, filterValue)
+				if err != nil {
+					return false, err
+				} else if match {
+					found = true
+					break
+				}
+			}
+			if !found {
+				all = false
+				break
+			}
+		}
+		if all {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// QueryValues returns the filters on the fields the API can filter on as
+// query parameters so fewer items are returned. The filters are kept so Match
+// still checks them in case the API ignores a parameter. Regex filters and
+// fields with more than one filter are left out since the API only matches
+// any of the values for a field.
+func (f *Filterable) QueryValues(fields ...string) url.Values {
+	q := url.Values{}
+	if f == nil {
+		return q
+	}
+
+	count := make(map[string]int)
+	for _, filter := range f.arr {
+		count[filter.key]++
+	}
+
+	allowed := make(map[string]bool)
+	for _, field := range fields {
+		allowed[field] = true
+	}
+
+	for _, filter := range f.arr {
+		if !allowed[filter.key] || filter.regex || count[filter.key] > 1 {
+			continue
+		}
+		for _, v := range filter.values {
+			q.Add(filter.key, fmt.Sprint(v))
+		}
+	}
+
+	return q
+}
+
 // Filter -
 type Filter struct {
 	key  string
@@ -170,6 +170,72 @@ func TestMatch(t *testing.T) {
 	assert.False(t, v)
 }
 
+func TestMatchSameListItem(t *testing.T) {
+	label := func(key, value string) map[string]interface{} {
+		return map[string]interface{}{"key": key, "value": value}
+	}
+	filterable := Filterable{
+		arr: []Filter{
+			{key: "labels.key", keys: []string{"labels", "key"}, values: []interface{}{"env"}},
+			{key: "labels.value", keys: []string{"labels", "value"}, values: []interface{}{"prod"}},
+		},
+	}
+
+	// Fail because the key and the value are on different labels.
+	data := map[string]interface{}{
+		"labels": []interface{}{label("env", "dev"), label("team", "prod")},
+	}
+	v, err := filterable.Match(data)
+	assert.NoError(t, err)
+	assert.False(t, v)
+
+	// Pass
+	data = map[string]interface{}{
+		"labels": []interface{}{label("team", "prod"), label("env", "prod")},
+	}
+	v, err = filterable.Match(data)
+	assert.NoError(t, err)
+	assert.True(t, v)
+
+	// Pass because repeated filters on the same field can match different
+	// items in the list.
+	filterable = Filterable{
+		arr: []Filter{
+			{key: "owner_users.id", keys: []string{"owner_users", "id"}, values: []interface{}{"100"}},
+			{key: "owner_users.id", keys: []string{"owner_users", "id"}, values: []interface{}{"300"}},
+		},
+	}
+	v, err = filterable.Match(map[string]interface{}{"owner_users": inflateIntArray([]int{300, 100})})
+	assert.NoError(t, err)
+	assert.True(t, v)
+}
+
+func TestQueryValues(t *testing.T) {
+	filterable := &Filterable{
+		arr: []Filter{
+			{key: "severity", keys: []string{"severity"}, values: []interface{}{"critical", "high"}},
+			{key: "region", keys: []string{"region"}, values: []interface{}{"us-.*"}, regex: true},
+			{key: "account_id", keys: []string{"account_id"}, values: []interface{}{"4"}},
+			{key: "account_id", keys: []string{"account_id"}, values: []interface{}{"5"}},
+			{key: "resource_name", keys: []string{"resource_name"}, values: []interface{}{"bucket-a"}},
+		},
+	}
+
+	q := filterable.QueryValues("account_id", "region", "severity")
+	assert.Equal(t, "severity=critical&severity=high", q.Encode())
+
+	// Every filter is still matched in case the API ignores a parameter.
+	keys := make([]string, 0)
+	for _, filter := range filterable.arr {
+		keys = append(keys, filter.key)
+	}
+	assert.Equal(t, []string{"severity", "region", "account_id", "account_id", "resource_name"}, keys)
+
+	// No filters
+	var empty *Filterable
+	assert.Empty(t, empty.QueryValues("severity"))
+}
+
 func TestExtractValue(t *testing.T) {
 	m1 := make(map[string]interface{})
 	m1["id"] = 100
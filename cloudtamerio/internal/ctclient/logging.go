@@ -0,0 +1,130 @@
+package ctclient
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+// requestIDHeader is sent with every request so a failed request can be
+// matched up with the cloudtamer.io logs.
+const requestIDHeader = "X-Request-Id"
+
+// redacted replaces secrets in the logs.
+const redacted = "[REDACTED]"
+
+var (
+	// apiKeyPattern matches cloudtamer.io app API keys: app_N_XXXXXXXX.
+	apiKeyPattern = regexp.MustCompile(`app_\d+_[A-Za-z0-9]+`)
+
+	// secretFieldPattern matches JSON fields that hold secrets by the end of
+	// the field name, like bind_password or saml_token. Other fields with key
+	// in the name, like the key of a label, are kept.
+	secretFieldPattern = regexp.MustCompile(`(?i)("[^"]*(?:password|secret|token|api_?key|access_key|private_key)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// requestHeadersPattern matches the request_headers object of a webhook.
+	// The header names are set by the user so the whole object is replaced
+	// since any of them can hold credentials for the target.
+	requestHeadersPattern = regexp.MustCompile(`("request_headers"\s*:\s*)\{(?:[^{}"]|"(?:[^"\\]|\\.)*")*\}`)
+
+	// secretHeaders are replaced in the logs.
+	secretHeaders = map[string]bool{
+		"Authorization": true,
+		"Cookie":        true,
+		"Set-Cookie":    true,
+	}
+)
+
+// newRequestID returns a random ID for the request ID header.
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// logRequest writes a single line for a finished request at DEBUG level. At
+// TRACE level the headers and bodies are included with secrets redacted.
+func logRequest(req *http.Request, res *http.Response, resBody []byte, duration time.Duration, err error) {
+	if !logging.IsDebugOrHigher() {
+		return
+	}
+
+	requestID := req.Header.Get(requestIDHeader)
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+		// Prefer the ID assigned by the server if it sends one back.
+		if v := res.Header.Get(requestIDHeader); v != "" {
+			requestID = v
+		}
+	}
+
+	if err != nil {
+		log.Printf("[DEBUG] cloudtamer.io API request: method=%s path=%s status=%d duration=%s request_id=%s error=%q",
+			req.Method, req.URL.Path, status, duration, requestID, redactString(err.Error()))
+	} else {
+		log.Printf("[DEBUG] cloudtamer.io API request: method=%s path=%s status=%d duration=%s request_id=%s",
+			req.Method, req.URL.Path, status, duration, requestID)
+	}
+
+	if logging.LogLevel() != "TRACE" {
+		return
+	}
+
+	// Read the request body from a copy so it can still be sent on a retry.
+	var reqBody []byte
+	if req.GetBody != nil {
+		if b, err := req.GetBody(); err == nil {
+			reqBody, _ = ioutil.ReadAll(b)
+			b.Close()
+		}
+	}
+
+	log.Printf("[TRACE] cloudtamer.io API request: request_id=%s url=%s headers=%s body=%s",
+		requestID, redactString(req.URL.String()), redactHeaders(req.Header), redactString(string(reqBody)))
+	if res != nil {
+		log.Printf("[TRACE] cloudtamer.io API response: request_id=%s status=%d headers=%s body=%s",
+			requestID, status, redactHeaders(res.Header), redactString(string(resBody)))
+	}
+}
+
+// redactHeaders returns the headers as a string with secret values removed.
+func redactHeaders(h http.Header) string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	arr := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := strings.Join(h[k], ", ")
+		if secretHeaders[http.CanonicalHeaderKey(k)] {
+			v = redacted
+		} else {
+			v = redactString(v)
+		}
+		arr = append(arr, k+": "+v)
+	}
+
+	return "{" + strings.Join(arr, "; ") + "}"
+}
+
+// redactString removes API keys, secret JSON fields, and webhook request
+// headers from a string.
+func redactString(s string) string {
+	s = requestHeadersPattern.ReplaceAllString(s, `${1}"`+redacted+`"`)
+	s = secretFieldPattern.ReplaceAllString(s, `${1}"`+redacted+`"`)
+	return apiKeyPattern.ReplaceAllString(s, redacted)
+}
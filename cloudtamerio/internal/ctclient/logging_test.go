@@ -0,0 +1,93 @@
+package ctclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactString(t *testing.T) {
+	assert.Equal(t, "key is [REDACTED].", redactString("key is app_12_AbCdEf123."))
+	assert.Equal(t, `{"name":"ci","key":"[REDACTED]"}`, redactString(`{"name":"ci","key":"app_9_s3cr3t"}`))
+	assert.Equal(t, `{"api_key":"[REDACTED]","secret_access_key":"[REDACTED]"}`, redactString(`{"api_key":"s3cr3t","secret_access_key":"s3cr3t"}`))
+	assert.Equal(t, `{"password": "[REDACTED]", "username":"admin"}`, redactString(`{"password": "p\"w", "username":"admin"}`))
+	assert.Equal(t, `{"apikey":"[REDACTED]"}`, redactString(`{"apikey":"app_1_XXXX"}`))
+	assert.Equal(t, "nothing to hide", redactString("nothing to hide"))
+
+	// Fields that contain a secret word are redacted, like the bind password
+	// of an LDAP IDMS.
+	ldap := IdmsLdapCreate{BindPassword: "hunter2", BindUser: "cn=admin", Host: "ldap.example.com", Name: "LDAP"}
+	b, err := json.Marshal(ldap)
+	assert.NoError(t, err)
+	assert.NotContains(t, redactString(string(b)), "hunter2")
+	assert.Contains(t, redactString(string(b)), `"bind_password":"[REDACTED]"`)
+	assert.Contains(t, redactString(string(b)), `"bind_user":"cn=admin"`)
+
+	// Other fields with key in the name are kept, like the key of a label.
+	label := `{"key":"env","label_key":"team","key_id":"4","value":"prod"}`
+	assert.Equal(t, label, redactString(label))
+
+	// Webhook headers have user defined names so the whole map is redacted.
+	webhook := `{"name":"Notify","request_headers":{"X-Target-Auth":"s3cr3t","Accept":"text/plain"},"request_type":"POST"}`
+	assert.Equal(t, `{"name":"Notify","request_headers":"[REDACTED]","request_type":"POST"}`, redactString(webhook))
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer app_1_XXXXXXXX")
+	h.Set("Content-Type", "application/json")
+
+	v := redactHeaders(h)
+	assert.Equal(t, "{Authorization: [REDACTED]; Content-Type: application/json}", v)
+}
+
+func TestLogRequestRedactsSecrets(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"record_id":1,"status":201,"key":"app_1_NEWSECRET"}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	os.Setenv("TF_LOG", "TRACE")
+	defer os.Unsetenv("TF_LOG")
+
+	c, err := NewClient(ts.URL, "app_1_SUPERSECRET", TransportConfig{})
+	assert.NoError(t, err)
+	_, err = c.POST(context.Background(), "/v3/app-api-key", map[string]string{"password": "hunter2"})
+	assert.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "[DEBUG] cloudtamer.io API request: method=POST path=/api/v3/app-api-key status=201")
+	assert.Contains(t, out, "request_id=")
+	assert.Contains(t, out, "[TRACE] cloudtamer.io API response")
+	assert.NotContains(t, out, "SUPERSECRET")
+	assert.NotContains(t, out, "NEWSECRET")
+	assert.NotContains(t, out, "hunter2")
+}
+
+func TestLogRequestDisabled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":200}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	os.Unsetenv("TF_LOG")
+
+	c, err := NewClient(ts.URL, "app_1_SUPERSECRET", TransportConfig{})
+	assert.NoError(t, err)
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,35 @@
+package ctclient
+
+// AppAPIKeyResponse for: GET /api/v3/app-api-key/{id}
+type AppAPIKeyResponse struct {
+	Data struct {
+		CreatedAt string `json:"created_at"`
+		ExpiresAt string `json:"expires_at"`
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		UserID    int    `json:"user_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AppAPIKeyCreate for: POST /api/v3/app-api-key
+type AppAPIKeyCreate struct {
+	ExpiresAt *string `json:"expires_at"`
+	Name      string  `json:"name"`
+	UserID    *int    `json:"user_id"`
+}
+
+// AppAPIKeyCreateResponse for: POST /api/v3/app-api-key
+// The key is only returned when it is created.
+type AppAPIKeyCreateResponse struct {
+	Data struct {
+		ID  int    `json:"id"`
+		Key string `json:"key"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AppAPIKeyUpdate for: PATCH /api/v3/app-api-key/{id}
+type AppAPIKeyUpdate struct {
+	Name string `json:"name"`
+}
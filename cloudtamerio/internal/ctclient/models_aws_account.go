@@ -0,0 +1,92 @@
+package ctclient
+
+// AwsAccountListResponse for: GET /api/v3/account
+type AwsAccountListResponse struct {
+	Data []struct {
+		AccountAlias              string            `json:"account_alias"`
+		AccountNumber             string            `json:"account_number"`
+		AccountTypeID             int               `json:"account_type_id"`
+		CreatedAt                 string            `json:"created_at"`
+		Email                     string            `json:"email"`
+		ID                        int               `json:"id"`
+		IncludeLinkedAccountSpend bool              `json:"include_linked_account_spend"`
+		Labels                    []ObjectWithLabel `json:"labels"`
+		LinkedRole                string            `json:"linked_role"`
+		Name                      string            `json:"name"`
+		PayerID                   int               `json:"payer_id"`
+		ProjectID                 int               `json:"project_id"`
+		SkipAccessChecking        bool              `json:"skip_access_checking"`
+		StartDatecode             string            `json:"start_datecode"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsAccountResponse for: GET /api/v3/account/{id}
+type AwsAccountResponse struct {
+	Data struct {
+		AccountAlias              string            `json:"account_alias"`
+		AccountNumber             string            `json:"account_number"`
+		AccountTypeID             int               `json:"account_type_id"`
+		CreatedAt                 string            `json:"created_at"`
+		Email                     string            `json:"email"`
+		ID                        int               `json:"id"`
+		IncludeLinkedAccountSpend bool              `json:"include_linked_account_spend"`
+		Labels                    []ObjectWithLabel `json:"labels"`
+		LinkedRole                string            `json:"linked_role"`
+		Name                      string            `json:"name"`
+		PayerID                   int               `json:"payer_id"`
+		ProjectID                 int               `json:"project_id"`
+		SkipAccessChecking        bool              `json:"skip_access_checking"`
+		StartDatecode             string            `json:"start_datecode"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsAccountImport for: POST /api/v3/account?account-type=aws
+type AwsAccountImport struct {
+	AccountAlias              *string `json:"account_alias"`
+	AccountNumber             string  `json:"account_number"`
+	AccountTypeID             *int    `json:"account_type_id"`
+	IncludeLinkedAccountSpend bool    `json:"include_linked_account_spend"`
+	LabelIds                  *[]int  `json:"label_ids"`
+	LinkedRole                *string `json:"linked_role"`
+	Name                      string  `json:"name"`
+	PayerID                   int     `json:"payer_id"`
+	ProjectID                 int     `json:"project_id"`
+	SkipAccessChecking        bool    `json:"skip_access_checking"`
+	StartDatecode             *string `json:"start_datecode"`
+}
+
+// AwsAccountRequestCreate for: POST /api/v3/account-request?account-type=aws
+type AwsAccountRequestCreate struct {
+	AccountAlias              *string `json:"account_alias"`
+	AccountTypeID             *int    `json:"account_type_id"`
+	Email                     string  `json:"email"`
+	IncludeLinkedAccountSpend bool    `json:"include_linked_account_spend"`
+	LabelIds                  *[]int  `json:"label_ids"`
+	LinkedRole                *string `json:"linked_role"`
+	Name                      string  `json:"name"`
+	PayerID                   int     `json:"payer_id"`
+	ProjectID                 int     `json:"project_id"`
+}
+
+// AwsAccountRequestResponse for: GET /api/v3/account-request/{id}
+type AwsAccountRequestResponse struct {
+	Data struct {
+		AccountID int    `json:"account_id"`
+		ID        int    `json:"id"`
+		Message   string `json:"message"`
+		Status    string `json:"status"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsAccountUpdate for: PATCH /api/v3/account/{id}
+type AwsAccountUpdate struct {
+	AccountAlias              string `json:"account_alias"`
+	IncludeLinkedAccountSpend bool   `json:"include_linked_account_spend"`
+	LinkedRole                string `json:"linked_role"`
+	Name                      string `json:"name"`
+	PayerID                   int    `json:"payer_id"`
+	SkipAccessChecking        bool   `json:"skip_access_checking"`
+}
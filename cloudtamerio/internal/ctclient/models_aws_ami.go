@@ -0,0 +1,76 @@
+package ctclient
+
+// AwsAmiListResponse for: GET /api/v3/ami
+type AwsAmiListResponse struct {
+	Data []struct {
+		AccountID       int            `json:"account_id"`
+		AwsAmiID        string         `json:"aws_ami_id"`
+		Description     string         `json:"description"`
+		ExpiresAt       string         `json:"expires_at"`
+		ID              int            `json:"id"`
+		Name            string         `json:"name"`
+		Region          string         `json:"region"`
+		SharedAccounts  []ObjectWithID `json:"shared_accounts"`
+		SyncDeprecation bool           `json:"sync_deprecation"`
+		SyncTags        bool           `json:"sync_tags"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsAmiResponse for: GET /api/v3/ami/{id}
+type AwsAmiResponse struct {
+	Data struct {
+		Ami struct {
+			AccountID           int    `json:"account_id"`
+			AwsAmiID            string `json:"aws_ami_id"`
+			Description         string `json:"description"`
+			ExpirationAlertDays int    `json:"expiration_alert_days"`
+			ExpirationNotify    bool   `json:"expiration_notify"`
+			ExpiresAt           string `json:"expires_at"`
+			ID                  int    `json:"id"`
+			Name                string `json:"name"`
+			Region              string `json:"region"`
+			SyncDeprecation     bool   `json:"sync_deprecation"`
+			SyncTags            bool   `json:"sync_tags"`
+			UnavailableInAws    bool   `json:"unavailable_in_aws"`
+		} `json:"ami"`
+		OwnerUserGroups []ObjectWithID `json:"owner_user_groups"`
+		OwnerUsers      []ObjectWithID `json:"owner_users"`
+		SharedAccounts  []ObjectWithID `json:"shared_accounts"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsAmiCreate for: POST /api/v3/ami
+type AwsAmiCreate struct {
+	AccountID           int     `json:"account_id"`
+	AwsAmiID            string  `json:"aws_ami_id"`
+	Description         string  `json:"description"`
+	ExpirationAlertDays int     `json:"expiration_alert_days"`
+	ExpirationNotify    bool    `json:"expiration_notify"`
+	ExpiresAt           *string `json:"expires_at"`
+	Name                string  `json:"name"`
+	OwnerUserGroupIds   *[]int  `json:"owner_user_group_ids"`
+	OwnerUserIds        *[]int  `json:"owner_user_ids"`
+	Region              string  `json:"region"`
+	SharedAccountIds    *[]int  `json:"shared_account_ids"`
+	SyncDeprecation     bool    `json:"sync_deprecation"`
+	SyncTags            bool    `json:"sync_tags"`
+}
+
+// AwsAmiUpdate for: PATCH /api/v3/ami/{id}
+type AwsAmiUpdate struct {
+	Description         string  `json:"description"`
+	ExpirationAlertDays int     `json:"expiration_alert_days"`
+	ExpirationNotify    bool    `json:"expiration_notify"`
+	ExpiresAt           *string `json:"expires_at"`
+	Name                string  `json:"name"`
+	SyncDeprecation     bool    `json:"sync_deprecation"`
+	SyncTags            bool    `json:"sync_tags"`
+}
+
+// AwsAmiShare for: POST /api/v3/ami/{id}/share and
+// DELETE /api/v3/ami/{id}/share
+type AwsAmiShare struct {
+	AccountIds *[]int `json:"account_ids"`
+}
@@ -0,0 +1,51 @@
+package ctclient
+
+// AwsServiceCatalogPortfolioListResponse for: GET /api/v3/service-catalog-portfolio
+type AwsServiceCatalogPortfolioListResponse struct {
+	Data []struct {
+		AccountID      int    `json:"account_id"`
+		AwsPortfolioID string `json:"aws_portfolio_id"`
+		Description    string `json:"description"`
+		ID             int    `json:"id"`
+		Name           string `json:"name"`
+		Region         string `json:"region"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsServiceCatalogPortfolioResponse for: GET /api/v3/service-catalog-portfolio/{id}
+type AwsServiceCatalogPortfolioResponse struct {
+	Data struct {
+		OwnerUserGroups []ObjectWithID `json:"owner_user_groups"`
+		OwnerUsers      []ObjectWithID `json:"owner_users"`
+		Portfolio       struct {
+			AccountID       int    `json:"account_id"`
+			AwsPortfolioID  string `json:"aws_portfolio_id"`
+			Description     string `json:"description"`
+			ID              int    `json:"id"`
+			Name            string `json:"name"`
+			Region          string `json:"region"`
+			ShareTagOptions bool   `json:"share_tag_options"`
+		} `json:"portfolio"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AwsServiceCatalogPortfolioCreate for: POST /api/v3/service-catalog-portfolio
+type AwsServiceCatalogPortfolioCreate struct {
+	AccountID         int    `json:"account_id"`
+	AwsPortfolioID    string `json:"aws_portfolio_id"`
+	Description       string `json:"description"`
+	Name              string `json:"name"`
+	OwnerUserGroupIds *[]int `json:"owner_user_group_ids"`
+	OwnerUserIds      *[]int `json:"owner_user_ids"`
+	Region            string `json:"region"`
+	ShareTagOptions   bool   `json:"share_tag_options"`
+}
+
+// AwsServiceCatalogPortfolioUpdate for: PATCH /api/v3/service-catalog-portfolio/{id}
+type AwsServiceCatalogPortfolioUpdate struct {
+	Description     string `json:"description"`
+	Name            string `json:"name"`
+	ShareTagOptions bool   `json:"share_tag_options"`
+}
@@ -0,0 +1,57 @@
+package ctclient
+
+// AzureSubscriptionListResponse for: GET /api/v3/account?account-type=azure
+type AzureSubscriptionListResponse struct {
+	Data []struct {
+		AccountTypeID      int               `json:"account_type_id"`
+		CreatedAt          string            `json:"created_at"`
+		ID                 int               `json:"id"`
+		Labels             []ObjectWithLabel `json:"labels"`
+		Name               string            `json:"name"`
+		PayerID            int               `json:"payer_id"`
+		ProjectID          int               `json:"project_id"`
+		ResourceGroupName  string            `json:"resource_group_name"`
+		SkipAccessChecking bool              `json:"skip_access_checking"`
+		StartDatecode      string            `json:"start_datecode"`
+		SubscriptionUUID   string            `json:"subscription_uuid"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AzureSubscriptionResponse for: GET /api/v3/account/{id}?account-type=azure
+type AzureSubscriptionResponse struct {
+	Data struct {
+		AccountTypeID      int               `json:"account_type_id"`
+		CreatedAt          string            `json:"created_at"`
+		ID                 int               `json:"id"`
+		Labels             []ObjectWithLabel `json:"labels"`
+		Name               string            `json:"name"`
+		PayerID            int               `json:"payer_id"`
+		ProjectID          int               `json:"project_id"`
+		ResourceGroupName  string            `json:"resource_group_name"`
+		SkipAccessChecking bool              `json:"skip_access_checking"`
+		StartDatecode      string            `json:"start_datecode"`
+		SubscriptionUUID   string            `json:"subscription_uuid"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// AzureSubscriptionImport for: POST /api/v3/account?account-type=azure
+type AzureSubscriptionImport struct {
+	AccountTypeID      *int    `json:"account_type_id"`
+	LabelIds           *[]int  `json:"label_ids"`
+	Name               string  `json:"name"`
+	PayerID            int     `json:"payer_id"`
+	ProjectID          int     `json:"project_id"`
+	ResourceGroupName  *string `json:"resource_group_name"`
+	SkipAccessChecking bool    `json:"skip_access_checking"`
+	StartDatecode      *string `json:"start_datecode"`
+	SubscriptionUUID   string  `json:"subscription_uuid"`
+}
+
+// AzureSubscriptionUpdate for: PATCH /api/v3/account/{id}?account-type=azure
+type AzureSubscriptionUpdate struct {
+	Name               string `json:"name"`
+	PayerID            int    `json:"payer_id"`
+	SkipAccessChecking bool   `json:"skip_access_checking"`
+}
@@ -0,0 +1,63 @@
+package ctclient
+
+// BudgetEnforcementListResponse for: GET /api/v3/budget-enforcement
+type BudgetEnforcementListResponse struct {
+	Data []struct {
+		Action        string  `json:"action"`
+		Description   string  `json:"description"`
+		ID            int     `json:"id"`
+		OUID          int     `json:"ou_id"`
+		ProjectID     int     `json:"project_id"`
+		Threshold     float64 `json:"threshold"`
+		ThresholdType string  `json:"threshold_type"`
+		Timeframe     string  `json:"timeframe"`
+		WebhookID     int     `json:"webhook_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// BudgetEnforcementResponse for: GET /api/v3/budget-enforcement/{id}
+type BudgetEnforcementResponse struct {
+	Data struct {
+		BudgetEnforcement struct {
+			Action        string  `json:"action"`
+			Description   string  `json:"description"`
+			ID            int     `json:"id"`
+			OUID          int     `json:"ou_id"`
+			ProjectID     int     `json:"project_id"`
+			Threshold     float64 `json:"threshold"`
+			ThresholdType string  `json:"threshold_type"`
+			Timeframe     string  `json:"timeframe"`
+			WebhookID     int     `json:"webhook_id"`
+		} `json:"budget_enforcement"`
+		NotifyUserGroups []ObjectWithID `json:"notify_user_groups"`
+		NotifyUsers      []ObjectWithID `json:"notify_users"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// BudgetEnforcementCreate for: POST /api/v3/budget-enforcement
+type BudgetEnforcementCreate struct {
+	Action             string  `json:"action"`
+	Description        string  `json:"description"`
+	NotifyUserGroupIds *[]int  `json:"notify_user_group_ids"`
+	NotifyUserIds      *[]int  `json:"notify_user_ids"`
+	OUID               *int    `json:"ou_id"`
+	ProjectID          *int    `json:"project_id"`
+	Threshold          float64 `json:"threshold"`
+	ThresholdType      string  `json:"threshold_type"`
+	Timeframe          string  `json:"timeframe"`
+	WebhookID          *int    `json:"webhook_id"`
+}
+
+// BudgetEnforcementUpdate for: PATCH /api/v3/budget-enforcement/{id}
+type BudgetEnforcementUpdate struct {
+	Action             string  `json:"action"`
+	Description        string  `json:"description"`
+	NotifyUserGroupIds *[]int  `json:"notify_user_group_ids"`
+	NotifyUserIds      *[]int  `json:"notify_user_ids"`
+	Threshold          float64 `json:"threshold"`
+	ThresholdType      string  `json:"threshold_type"`
+	Timeframe          string  `json:"timeframe"`
+	WebhookID          *int    `json:"webhook_id"`
+}
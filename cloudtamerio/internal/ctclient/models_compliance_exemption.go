@@ -0,0 +1,54 @@
+package ctclient
+
+// ComplianceExemptionListResponse for: GET /api/v3/compliance/exemption
+type ComplianceExemptionListResponse struct {
+	Data []struct {
+		AccountID          int    `json:"account_id"`
+		ComplianceCheckID  int    `json:"compliance_check_id"`
+		CreatedAt          string `json:"created_at"`
+		CreatedByUserID    int    `json:"created_by_user_id"`
+		ExpiresAt          string `json:"expires_at"`
+		ID                 int    `json:"id"`
+		Justification      string `json:"justification"`
+		ProjectID          int    `json:"project_id"`
+		Region             string `json:"region"`
+		ResourceArnPattern string `json:"resource_arn_pattern"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// ComplianceExemptionResponse for: GET /api/v3/compliance/exemption/{id}
+type ComplianceExemptionResponse struct {
+	Data struct {
+		AccountID          int    `json:"account_id"`
+		ComplianceCheckID  int    `json:"compliance_check_id"`
+		CreatedAt          string `json:"created_at"`
+		CreatedByUserID    int    `json:"created_by_user_id"`
+		ExpiresAt          string `json:"expires_at"`
+		ID                 int    `json:"id"`
+		Justification      string `json:"justification"`
+		ProjectID          int    `json:"project_id"`
+		Region             string `json:"region"`
+		ResourceArnPattern string `json:"resource_arn_pattern"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// ComplianceExemptionCreate for: POST /api/v3/compliance/exemption
+type ComplianceExemptionCreate struct {
+	AccountID          *int    `json:"account_id"`
+	ComplianceCheckID  int     `json:"compliance_check_id"`
+	ExpiresAt          *string `json:"expires_at"`
+	Justification      string  `json:"justification"`
+	ProjectID          *int    `json:"project_id"`
+	Region             string  `json:"region"`
+	ResourceArnPattern string  `json:"resource_arn_pattern"`
+}
+
+// ComplianceExemptionUpdate for: PATCH /api/v3/compliance/exemption/{id}
+type ComplianceExemptionUpdate struct {
+	ExpiresAt          *string `json:"expires_at"`
+	Justification      string  `json:"justification"`
+	Region             string  `json:"region"`
+	ResourceArnPattern string  `json:"resource_arn_pattern"`
+}
@@ -0,0 +1,34 @@
+package ctclient
+
+// ComplianceFindingListResponse for: GET /api/v3/compliance/finding
+type ComplianceFindingListResponse struct {
+	Data []struct {
+		AccountID         int    `json:"account_id"`
+		ComplianceCheckID int    `json:"compliance_check_id"`
+		FoundAt           string `json:"found_at"`
+		ID                int    `json:"id"`
+		ProjectID         int    `json:"project_id"`
+		Region            string `json:"region"`
+		ResourceName      string `json:"resource_name"`
+		ResourceType      string `json:"resource_type"`
+		ScanID            int    `json:"scan_id"`
+		Severity          string `json:"severity"`
+		SeverityTypeID    int    `json:"severity_type_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// ComplianceScanListResponse for: GET /api/v3/compliance/scan
+type ComplianceScanListResponse struct {
+	Data []struct {
+		AccountID         int    `json:"account_id"`
+		ComplianceCheckID int    `json:"compliance_check_id"`
+		FindingCount      int    `json:"finding_count"`
+		FinishedAt        string `json:"finished_at"`
+		ID                int    `json:"id"`
+		Region            string `json:"region"`
+		StartedAt         string `json:"started_at"`
+		Status            string `json:"status"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
@@ -0,0 +1,34 @@
+package ctclient
+
+// ComplianceStandardAttachmentResponse for: GET /api/v3/compliance/standard-attachment/{id}
+type ComplianceStandardAttachmentResponse struct {
+	Data struct {
+		AccountID              int            `json:"account_id"`
+		ComplianceStandardID   int            `json:"compliance_standard_id"`
+		EnforcementMode        string         `json:"enforcement_mode"`
+		ExemptAccounts         []ObjectWithID `json:"exempt_accounts"`
+		ExemptComplianceChecks []ObjectWithID `json:"exempt_compliance_checks"`
+		ID                     int            `json:"id"`
+		OUID                   int            `json:"ou_id"`
+		ProjectID              int            `json:"project_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// ComplianceStandardAttachmentCreate for: POST /api/v3/compliance/standard-attachment
+type ComplianceStandardAttachmentCreate struct {
+	AccountID                *int   `json:"account_id"`
+	ComplianceStandardID     int    `json:"compliance_standard_id"`
+	EnforcementMode          string `json:"enforcement_mode"`
+	ExemptAccountIds         *[]int `json:"exempt_account_ids"`
+	ExemptComplianceCheckIds *[]int `json:"exempt_compliance_check_ids"`
+	OUID                     *int   `json:"ou_id"`
+	ProjectID                *int   `json:"project_id"`
+}
+
+// ComplianceStandardAttachmentUpdate for: PATCH /api/v3/compliance/standard-attachment/{id}
+type ComplianceStandardAttachmentUpdate struct {
+	EnforcementMode          string `json:"enforcement_mode"`
+	ExemptAccountIds         *[]int `json:"exempt_account_ids"`
+	ExemptComplianceCheckIds *[]int `json:"exempt_compliance_check_ids"`
+}
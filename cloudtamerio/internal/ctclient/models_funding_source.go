@@ -0,0 +1,57 @@
+package ctclient
+
+// FundingSourceListResponse for: GET /api/v3/funding-source
+type FundingSourceListResponse struct {
+	Data []struct {
+		Amount        float64 `json:"amount"`
+		CreatedAt     string  `json:"created_at"`
+		Description   string  `json:"description"`
+		EndDatecode   string  `json:"end_datecode"`
+		ID            int     `json:"id"`
+		Name          string  `json:"name"`
+		OUID          int     `json:"ou_id"`
+		StartDatecode string  `json:"start_datecode"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// FundingSourceResponse for: GET /api/v3/funding-source/{id}
+type FundingSourceResponse struct {
+	Data struct {
+		FundingSource struct {
+			Amount        float64 `json:"amount"`
+			CreatedAt     string  `json:"created_at"`
+			Description   string  `json:"description"`
+			EndDatecode   string  `json:"end_datecode"`
+			ID            int     `json:"id"`
+			Name          string  `json:"name"`
+			OUID          int     `json:"ou_id"`
+			StartDatecode string  `json:"start_datecode"`
+		} `json:"funding_source"`
+		OwnerUserGroups []ObjectWithID `json:"owner_user_groups"`
+		OwnerUsers      []ObjectWithID `json:"owner_users"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// FundingSourceCreate for: POST /api/v3/funding-source
+type FundingSourceCreate struct {
+	Amount             float64 `json:"amount"`
+	Description        string  `json:"description"`
+	EndDatecode        string  `json:"end_datecode"`
+	Name               string  `json:"name"`
+	OUID               int     `json:"ou_id"`
+	OwnerUserGroupIds  *[]int  `json:"owner_user_group_ids"`
+	OwnerUserIds       *[]int  `json:"owner_user_ids"`
+	PermissionSchemeID int     `json:"permission_scheme_id"`
+	StartDatecode      string  `json:"start_datecode"`
+}
+
+// FundingSourceUpdate for: PATCH /api/v3/funding-source/{id}
+type FundingSourceUpdate struct {
+	Amount        float64 `json:"amount"`
+	Description   string  `json:"description"`
+	EndDatecode   string  `json:"end_datecode"`
+	Name          string  `json:"name"`
+	StartDatecode string  `json:"start_datecode"`
+}
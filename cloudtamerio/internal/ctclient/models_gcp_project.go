@@ -0,0 +1,54 @@
+package ctclient
+
+// GcpProjectListResponse for: GET /api/v3/account?account-type=gcp
+type GcpProjectListResponse struct {
+	Data []struct {
+		AccountTypeID      int               `json:"account_type_id"`
+		CreatedAt          string            `json:"created_at"`
+		GcpProjectID       string            `json:"google_cloud_project_id"`
+		ID                 int               `json:"id"`
+		Labels             []ObjectWithLabel `json:"labels"`
+		Name               string            `json:"name"`
+		PayerID            int               `json:"payer_id"`
+		ProjectID          int               `json:"project_id"`
+		SkipAccessChecking bool              `json:"skip_access_checking"`
+		StartDatecode      string            `json:"start_datecode"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// GcpProjectResponse for: GET /api/v3/account/{id}?account-type=gcp
+type GcpProjectResponse struct {
+	Data struct {
+		AccountTypeID      int               `json:"account_type_id"`
+		CreatedAt          string            `json:"created_at"`
+		GcpProjectID       string            `json:"google_cloud_project_id"`
+		ID                 int               `json:"id"`
+		Labels             []ObjectWithLabel `json:"labels"`
+		Name               string            `json:"name"`
+		PayerID            int               `json:"payer_id"`
+		ProjectID          int               `json:"project_id"`
+		SkipAccessChecking bool              `json:"skip_access_checking"`
+		StartDatecode      string            `json:"start_datecode"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// GcpProjectImport for: POST /api/v3/account?account-type=gcp
+type GcpProjectImport struct {
+	AccountTypeID      *int    `json:"account_type_id"`
+	GcpProjectID       string  `json:"google_cloud_project_id"`
+	LabelIds           *[]int  `json:"label_ids"`
+	Name               string  `json:"name"`
+	PayerID            int     `json:"payer_id"`
+	ProjectID          int     `json:"project_id"`
+	SkipAccessChecking bool    `json:"skip_access_checking"`
+	StartDatecode      *string `json:"start_datecode"`
+}
+
+// GcpProjectUpdate for: PATCH /api/v3/account/{id}?account-type=gcp
+type GcpProjectUpdate struct {
+	Name               string `json:"name"`
+	PayerID            int    `json:"payer_id"`
+	SkipAccessChecking bool   `json:"skip_access_checking"`
+}
@@ -0,0 +1,88 @@
+package ctclient
+
+// IdmsListResponse for: GET /api/v3/idms
+type IdmsListResponse struct {
+	Data []struct {
+		ID         int    `json:"id"`
+		IdmsTypeID int    `json:"idms_type_id"`
+		Name       string `json:"name"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// IdmsSamlResponse for: GET /api/v3/idms/{id}?idms-type=saml
+type IdmsSamlResponse struct {
+	Data struct {
+		EmailAttribute     string `json:"email_attribute"`
+		EntityID           string `json:"entity_id"`
+		FirstNameAttribute string `json:"first_name_attribute"`
+		GroupsAttribute    string `json:"groups_attribute"`
+		ID                 int    `json:"id"`
+		IdmsTypeID         int    `json:"idms_type_id"`
+		LastNameAttribute  string `json:"last_name_attribute"`
+		MetadataURL        string `json:"metadata_url"`
+		MetadataXML        string `json:"metadata_xml"`
+		Name               string `json:"name"`
+		UsernameAttribute  string `json:"username_attribute"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// IdmsSamlCreate for: POST /api/v3/idms?idms-type=saml
+// and PATCH /api/v3/idms/{id}?idms-type=saml
+type IdmsSamlCreate struct {
+	EmailAttribute     string  `json:"email_attribute"`
+	EntityID           string  `json:"entity_id"`
+	FirstNameAttribute string  `json:"first_name_attribute"`
+	GroupsAttribute    string  `json:"groups_attribute"`
+	LastNameAttribute  string  `json:"last_name_attribute"`
+	MetadataURL        *string `json:"metadata_url"`
+	MetadataXML        *string `json:"metadata_xml"`
+	Name               string  `json:"name"`
+	UsernameAttribute  string  `json:"username_attribute"`
+}
+
+// IdmsLdapResponse for: GET /api/v3/idms/{id}?idms-type=ldap
+type IdmsLdapResponse struct {
+	Data struct {
+		BaseDN               string `json:"base_dn"`
+		BindUser             string `json:"bind_user"`
+		EmailAttribute       string `json:"email_attribute"`
+		FirstNameAttribute   string `json:"first_name_attribute"`
+		GroupBaseDN          string `json:"group_base_dn"`
+		GroupMemberAttribute string `json:"group_member_attribute"`
+		GroupNameAttribute   string `json:"group_name_attribute"`
+		Host                 string `json:"host"`
+		ID                   int    `json:"id"`
+		IdmsTypeID           int    `json:"idms_type_id"`
+		LastNameAttribute    string `json:"last_name_attribute"`
+		Name                 string `json:"name"`
+		Port                 int    `json:"port"`
+		SkipTLSVerify        bool   `json:"skip_tls_verify"`
+		UseTLS               bool   `json:"use_tls"`
+		UserBaseDN           string `json:"user_base_dn"`
+		UsernameAttribute    string `json:"username_attribute"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// IdmsLdapCreate for: POST /api/v3/idms?idms-type=ldap
+// and PATCH /api/v3/idms/{id}?idms-type=ldap
+type IdmsLdapCreate struct {
+	BaseDN               string `json:"base_dn"`
+	BindPassword         string `json:"bind_password"`
+	BindUser             string `json:"bind_user"`
+	EmailAttribute       string `json:"email_attribute"`
+	FirstNameAttribute   string `json:"first_name_attribute"`
+	GroupBaseDN          string `json:"group_base_dn"`
+	GroupMemberAttribute string `json:"group_member_attribute"`
+	GroupNameAttribute   string `json:"group_name_attribute"`
+	Host                 string `json:"host"`
+	LastNameAttribute    string `json:"last_name_attribute"`
+	Name                 string `json:"name"`
+	Port                 int    `json:"port"`
+	SkipTLSVerify        bool   `json:"skip_tls_verify"`
+	UseTLS               bool   `json:"use_tls"`
+	UserBaseDN           string `json:"user_base_dn"`
+	UsernameAttribute    string `json:"username_attribute"`
+}
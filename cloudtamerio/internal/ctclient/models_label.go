@@ -0,0 +1,41 @@
+package ctclient
+
+// LabelListResponse for: GET /api/v3/label
+type LabelListResponse struct {
+	Data   []ObjectWithLabel `json:"data"`
+	Status int               `json:"status"`
+}
+
+// LabelResponse for: GET /api/v3/label/{id}
+type LabelResponse struct {
+	Data   ObjectWithLabel `json:"data"`
+	Status int             `json:"status"`
+}
+
+// LabelCreate for: POST /api/v3/label
+type LabelCreate struct {
+	Color string `json:"color"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LabelUpdate for: PATCH /api/v3/label/{id}
+type LabelUpdate struct {
+	Color string `json:"color"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ObjectWithLabel is a label as it is returned on an OU, project, or account.
+type ObjectWithLabel struct {
+	Color string `json:"color"`
+	ID    int    `json:"id"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ChangeLabels for: POST /api/v3/{ou|project|account}/{id}/label and
+// DELETE /api/v3/{ou|project|account}/{id}/label
+type ChangeLabels struct {
+	LabelIds *[]int `json:"label_ids"`
+}
@@ -0,0 +1,30 @@
+package ctclient
+
+// LoginCreate for: POST /api/v3/login
+type LoginCreate struct {
+	IdmsID   int    `json:"idms"`
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// LoginSAMLCreate for: POST /api/v3/login/saml
+type LoginSAMLCreate struct {
+	IdmsID    int    `json:"idms"`
+	SAMLToken string `json:"saml_token"`
+}
+
+// LoginResponse for: POST /api/v3/login
+type LoginResponse struct {
+	Data struct {
+		Access struct {
+			Expiry string `json:"expiry"`
+			Token  string `json:"token"`
+		} `json:"access"`
+		Refresh struct {
+			Expiry string `json:"expiry"`
+			Token  string `json:"token"`
+		} `json:"refresh"`
+		UserID int `json:"user_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
@@ -0,0 +1,22 @@
+package ctclient
+
+// MeResponse for: GET /api/v3/me
+type MeResponse struct {
+	Data struct {
+		Email     string `json:"email"`
+		FirstName string `json:"first_name"`
+		ID        int    `json:"id"`
+		IdmsID    int    `json:"idms_id"`
+		LastName  string `json:"last_name"`
+		Username  string `json:"username"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// VersionResponse for: GET /api/v3/version
+type VersionResponse struct {
+	Data struct {
+		Version string `json:"version"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
@@ -3,12 +3,13 @@ package ctclient
 // OUListResponse for: GET /api/v3/ou
 type OUListResponse struct {
 	Data []struct {
-		CreatedAt          string `json:"created_at"`
-		Description        string `json:"description"`
-		ID                 int    `json:"id"`
-		Name               string `json:"name"`
-		ParentOuID         int    `json:"parent_ou_id"`
-		PermissionSchemeID int    `json:"permission_scheme_id"`
+		CreatedAt          string            `json:"created_at"`
+		Description        string            `json:"description"`
+		ID                 int               `json:"id"`
+		Labels             []ObjectWithLabel `json:"labels"`
+		Name               string            `json:"name"`
+		ParentOuID         int               `json:"parent_ou_id"`
+		PermissionSchemeID int               `json:"permission_scheme_id"`
 	} `json:"data"`
 	Status int `json:"status"`
 }
@@ -24,8 +25,9 @@ type OUResponse struct {
 			ParentOuID         int    `json:"parent_ou_id"`
 			PermissionSchemeID int    `json:"permission_scheme_id"`
 		} `json:"ou"`
-		OwnerUserGroups []ObjectWithID `json:"owner_user_groups"`
-		OwnerUsers      []ObjectWithID `json:"owner_users"`
+		Labels          []ObjectWithLabel `json:"labels"`
+		OwnerUserGroups []ObjectWithID    `json:"owner_user_groups"`
+		OwnerUsers      []ObjectWithID    `json:"owner_users"`
 	} `json:"data"`
 	Status int `json:"status"`
 }
@@ -33,6 +35,7 @@ type OUResponse struct {
 // OUCreate for: POST /api/v3/ou
 type OUCreate struct {
 	Description        string `json:"description"`
+	LabelIds           *[]int `json:"label_ids"`
 	Name               string `json:"name"`
 	OwnerUserGroupIds  *[]int `json:"owner_user_group_ids"`
 	OwnerUserIds       *[]int `json:"owner_user_ids"`
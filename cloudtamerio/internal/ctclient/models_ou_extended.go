@@ -1,6 +1,7 @@
 package ctclient
 
 // OUPermissionAdd for: POST /v3/ou/{id}/permission-mapping
+// and POST /v3/project/{id}/permission-mapping
 type OUPermissionAdd struct {
 	AppRoleID         *int   `json:"app_role_id"`
 	OwnerUserGroupIds *[]int `json:"user_groups_ids"`
@@ -8,3 +9,22 @@ type OUPermissionAdd struct {
 	PostWebhookID     *int   `json:"post_webhook_id"`
 	PreWebhookID      *int   `json:"pre_webhook_id"`
 }
+
+// OUPermissionRemove for: DELETE /v3/ou/{id}/permission-mapping
+// and DELETE /v3/project/{id}/permission-mapping
+type OUPermissionRemove struct {
+	AppRoleID         *int   `json:"app_role_id"`
+	OwnerUserGroupIds *[]int `json:"user_groups_ids"`
+	OwnerUserIds      *[]int `json:"user_ids"`
+}
+
+// OUPermissionListResponse for: GET /v3/ou/{id}/permission-mapping
+// and GET /v3/project/{id}/permission-mapping
+type OUPermissionListResponse struct {
+	Data []struct {
+		AppRoleID     int   `json:"app_role_id"`
+		UserGroupsIds []int `json:"user_groups_ids"`
+		UserIds       []int `json:"user_ids"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
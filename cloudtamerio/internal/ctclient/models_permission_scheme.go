@@ -0,0 +1,13 @@
+package ctclient
+
+// PermissionSchemeListResponse for: GET /api/v3/permission-scheme
+type PermissionSchemeListResponse struct {
+	Data []struct {
+		Description  string `json:"description"`
+		ID           int    `json:"id"`
+		Name         string `json:"name"`
+		SchemeTypeID int    `json:"scheme_type_id"`
+		System       bool   `json:"system"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
@@ -3,13 +3,14 @@ package ctclient
 // ProjectListResponse for: GET /api/v3/project
 type ProjectListResponse struct {
 	Data []struct {
-		Archived         bool   `json:"archived"`
-		AutoPay          bool   `json:"auto_pay"`
-		DefaultAwsRegion string `json:"default_aws_region"`
-		Description      string `json:"description"`
-		ID               int    `json:"id"`
-		Name             string `json:"name"`
-		OUID             int    `json:"ou_id"`
+		Archived         bool              `json:"archived"`
+		AutoPay          bool              `json:"auto_pay"`
+		DefaultAwsRegion string            `json:"default_aws_region"`
+		Description      string            `json:"description"`
+		ID               int               `json:"id"`
+		Labels           []ObjectWithLabel `json:"labels"`
+		Name             string            `json:"name"`
+		OUID             int               `json:"ou_id"`
 	} `json:"data"`
 	Status int `json:"status"`
 }
@@ -17,13 +18,14 @@ type ProjectListResponse struct {
 // ProjectResponse for: GET /api/v3/project/{id}
 type ProjectResponse struct {
 	Data struct {
-		Archived         bool   `json:"archived"`
-		AutoPay          bool   `json:"auto_pay"`
-		DefaultAwsRegion string `json:"default_aws_region"`
-		Description      string `json:"description"`
-		ID               int    `json:"id"`
-		Name             string `json:"name"`
-		OUID             int    `json:"ou_id"`
+		Archived         bool              `json:"archived"`
+		AutoPay          bool              `json:"auto_pay"`
+		DefaultAwsRegion string            `json:"default_aws_region"`
+		Description      string            `json:"description"`
+		ID               int               `json:"id"`
+		Labels           []ObjectWithLabel `json:"labels"`
+		Name             string            `json:"name"`
+		OUID             int               `json:"ou_id"`
 	} `json:"data"`
 	Status int `json:"status"`
 }
@@ -33,6 +35,7 @@ type ProjectCreate struct {
 	AutoPay            bool                   `json:"auto_pay"`
 	DefaultAwsRegion   string                 `json:"default_aws_region"`
 	Description        string                 `json:"description"`
+	LabelIds           *[]int                 `json:"label_ids"`
 	Name               string                 `json:"name"`
 	OUID               int                    `json:"ou_id"`
 	OwnerUserGroupIds  *[]int                 `json:"owner_user_group_ids"`
@@ -1,6 +1,6 @@
 package ctclient
 
-// ProjectFundingCreate for: POST /v3/project
+// ProjectFundingCreate for: POST /api/v3/project and POST /api/v3/project/{id}/funding
 type ProjectFundingCreate struct {
 	FundingSourceID int     `json:"funding_source_id"`
 	Amount          float64 `json:"amount"`
@@ -8,3 +8,29 @@ type ProjectFundingCreate struct {
 	EndDatecode     string  `json:"end_datecode"`
 	FundingOrder    int     `json:"funding_order"`
 }
+
+// ProjectFundingListResponse for: GET /api/v3/project/{id}/funding
+type ProjectFundingListResponse struct {
+	Data   []ProjectFunding `json:"data"`
+	Status int              `json:"status"`
+}
+
+// ProjectFunding is a single funding source on a project.
+type ProjectFunding struct {
+	Amount          float64 `json:"amount"`
+	EndDatecode     string  `json:"end_datecode"`
+	FundingOrder    int     `json:"funding_order"`
+	FundingSourceID int     `json:"funding_source_id"`
+	ID              int     `json:"id"`
+	ProjectID       int     `json:"project_id"`
+	StartDatecode   string  `json:"start_datecode"`
+}
+
+// ProjectFundingUpdate for: PATCH /api/v3/project-funding/{id}
+type ProjectFundingUpdate struct {
+	Amount          float64 `json:"amount"`
+	EndDatecode     string  `json:"end_datecode"`
+	FundingOrder    int     `json:"funding_order"`
+	FundingSourceID int     `json:"funding_source_id"`
+	StartDatecode   string  `json:"start_datecode"`
+}
@@ -43,3 +43,10 @@ type ChangeOwners struct {
 	OwnerUserGroupIds *[]int `json:"owner_user_group_ids"`
 	OwnerUserIds      *[]int `json:"owner_user_ids"`
 }
+
+// AccountMove for: POST /api/v3/account/{id}/move
+type AccountMove struct {
+	Financials   string  `json:"financials"`
+	MoveDatecode *string `json:"move_datecode"`
+	ProjectID    int     `json:"project_id"`
+}
@@ -0,0 +1,48 @@
+package ctclient
+
+// SpendPlanListResponse for: GET /api/v3/spend-plan
+type SpendPlanListResponse struct {
+	Data []struct {
+		ID           int     `json:"id"`
+		Name         string  `json:"name"`
+		OUID         int     `json:"ou_id"`
+		ProjectID    int     `json:"project_id"`
+		TotalPlanned float64 `json:"total_planned"`
+		TotalSpend   float64 `json:"total_spend"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// SpendPlanResponse for: GET /api/v3/spend-plan/{id}
+type SpendPlanResponse struct {
+	Data struct {
+		CreatedAt string           `json:"created_at"`
+		Entries   []SpendPlanEntry `json:"entries"`
+		ID        int              `json:"id"`
+		Name      string           `json:"name"`
+		OUID      int              `json:"ou_id"`
+		ProjectID int              `json:"project_id"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// SpendPlanEntry is the planned amount for a month from a funding source.
+type SpendPlanEntry struct {
+	Amount          float64 `json:"amount"`
+	Datecode        string  `json:"datecode"`
+	FundingSourceID int     `json:"funding_source_id"`
+}
+
+// SpendPlanCreate for: POST /api/v3/spend-plan
+type SpendPlanCreate struct {
+	Entries   []SpendPlanEntry `json:"entries"`
+	Name      string           `json:"name"`
+	OUID      *int             `json:"ou_id"`
+	ProjectID *int             `json:"project_id"`
+}
+
+// SpendPlanUpdate for: PATCH /api/v3/spend-plan/{id}
+type SpendPlanUpdate struct {
+	Entries []SpendPlanEntry `json:"entries"`
+	Name    string           `json:"name"`
+}
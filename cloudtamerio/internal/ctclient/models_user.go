@@ -0,0 +1,58 @@
+package ctclient
+
+// UserListResponse for: GET /api/v3/user
+type UserListResponse struct {
+	Data []struct {
+		CreatedAt   string `json:"created_at"`
+		DisplayName string `json:"display_name"`
+		Email       string `json:"email"`
+		Enabled     bool   `json:"enabled"`
+		FirstName   string `json:"first_name"`
+		ID          int    `json:"id"`
+		IdmsID      int    `json:"idms_id"`
+		LastLogin   string `json:"last_login"`
+		LastName    string `json:"last_name"`
+		Phone       string `json:"phone"`
+		Username    string `json:"username"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// UserResponse for: GET /api/v3/user/{id}
+type UserResponse struct {
+	Data struct {
+		CreatedAt   string `json:"created_at"`
+		DisplayName string `json:"display_name"`
+		Email       string `json:"email"`
+		Enabled     bool   `json:"enabled"`
+		FirstName   string `json:"first_name"`
+		ID          int    `json:"id"`
+		IdmsID      int    `json:"idms_id"`
+		LastLogin   string `json:"last_login"`
+		LastName    string `json:"last_name"`
+		Phone       string `json:"phone"`
+		Username    string `json:"username"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// UserCreate for: POST /api/v3/user
+type UserCreate struct {
+	Email     string `json:"email"`
+	Enabled   bool   `json:"enabled"`
+	FirstName string `json:"first_name"`
+	IdmsID    int    `json:"idms_id"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+	Username  string `json:"username"`
+}
+
+// UserUpdate for: PATCH /api/v3/user/{id}
+type UserUpdate struct {
+	Email     string `json:"email"`
+	Enabled   bool   `json:"enabled"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Phone     string `json:"phone"`
+	Username  string `json:"username"`
+}
@@ -0,0 +1,63 @@
+package ctclient
+
+// WebhookListResponse for: GET /api/v3/webhook
+type WebhookListResponse struct {
+	Data []struct {
+		CalloutURL       string `json:"callout_url"`
+		CreatedAt        string `json:"created_at"`
+		Description      string `json:"description"`
+		ID               int    `json:"id"`
+		Name             string `json:"name"`
+		RequestType      string `json:"request_type"`
+		SkipSSLVerify    bool   `json:"skip_ssl_verify"`
+		TimeoutInSeconds int    `json:"timeout_in_seconds"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// WebhookResponse for: GET /api/v3/webhook/{id}
+type WebhookResponse struct {
+	Data struct {
+		OwnerUserGroups []ObjectWithID `json:"owner_user_groups"`
+		OwnerUsers      []ObjectWithID `json:"owner_users"`
+		Webhook         struct {
+			CalloutURL       string            `json:"callout_url"`
+			CreatedAt        string            `json:"created_at"`
+			Description      string            `json:"description"`
+			ID               int               `json:"id"`
+			Name             string            `json:"name"`
+			RequestBody      string            `json:"request_body"`
+			RequestHeaders   map[string]string `json:"request_headers"`
+			RequestType      string            `json:"request_type"`
+			SkipSSLVerify    bool              `json:"skip_ssl_verify"`
+			TimeoutInSeconds int               `json:"timeout_in_seconds"`
+		} `json:"webhook"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// WebhookCreate for: POST /api/v3/webhook
+type WebhookCreate struct {
+	CalloutURL        string            `json:"callout_url"`
+	Description       string            `json:"description"`
+	Name              string            `json:"name"`
+	OwnerUserGroupIds *[]int            `json:"owner_user_group_ids"`
+	OwnerUserIds      *[]int            `json:"owner_user_ids"`
+	RequestBody       string            `json:"request_body"`
+	RequestHeaders    map[string]string `json:"request_headers"`
+	RequestType       string            `json:"request_type"`
+	SkipSSLVerify     bool              `json:"skip_ssl_verify"`
+	TimeoutInSeconds  int               `json:"timeout_in_seconds"`
+}
+
+// WebhookUpdate for: PATCH /api/v3/webhook/{id}
+type WebhookUpdate struct {
+	CalloutURL       string            `json:"callout_url"`
+	Description      string            `json:"description"`
+	Name             string            `json:"name"`
+	RequestBody      string            `json:"request_body"`
+	RequestHeaders   map[string]string `json:"request_headers"`
+	RequestType      string            `json:"request_type"`
+	SkipSSLVerify    bool              `json:"skip_ssl_verify"`
+	TimeoutInSeconds int               `json:"timeout_in_seconds"`
+}
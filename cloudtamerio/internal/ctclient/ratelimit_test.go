@@ -0,0 +1,81 @@
+package ctclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterAllowsBurst(t *testing.T) {
+	l := newRateLimiter(1, 3)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, l.Wait(context.Background()))
+	}
+	assert.True(t, time.Since(start) < 500*time.Millisecond, "expected the burst to not block")
+}
+
+func TestRateLimiterBlocksAfterBurst(t *testing.T) {
+	l := newRateLimiter(20, 1)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, l.Wait(context.Background()))
+	}
+	// The first request is free, the next two wait 50ms each.
+	assert.True(t, time.Since(start) >= 90*time.Millisecond, "expected the limiter to block")
+}
+
+func TestRateLimiterStopsWhenContextIsCanceled(t *testing.T) {
+	l := newRateLimiter(0.01, 1)
+	assert.NoError(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Error(t, l.Wait(ctx))
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	var l *rateLimiter = newRateLimiter(0, 1)
+	assert.Nil(t, l)
+	assert.NoError(t, l.Wait(context.Background()))
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var current, peak int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		w.Write([]byte(`{"status":200}`))
+	}))
+	defer ts.Close()
+
+	c := newTestClient(ts)
+	c.SetMaxConcurrentRequests(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, atomic.LoadInt32(&peak) <= 2, "expected at most 2 requests in flight, got %d", peak)
+}
@@ -0,0 +1,118 @@
+package ctclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// generateCertPEM returns a self-signed certificate and key for testing.
+func generateCertPEM(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestTransportCustomCA(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":200}`))
+	}))
+	defer ts.Close()
+
+	// The test server certificate is not trusted by default.
+	c, err := NewClient(ts.URL, "app_1_test", TransportConfig{})
+	assert.NoError(t, err)
+	c.MaxRetries = 0
+	assert.Error(t, c.GET(context.Background(), "/v3/ou", nil))
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+	c, err = NewClient(ts.URL, "app_1_test", TransportConfig{CACertPEM: caPEM})
+	assert.NoError(t, err)
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+}
+
+func TestTransportMutualTLS(t *testing.T) {
+	certPEM, keyPEM := generateCertPEM(t)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"status":200}`))
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	c, err := NewClient(ts.URL, "app_1_test", TransportConfig{
+		SkipSSLValidation: true,
+		ClientCertPEM:     certPEM,
+		ClientKeyPEM:      keyPEM,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+}
+
+func TestTransportInvalidConfig(t *testing.T) {
+	certPEM, keyPEM := generateCertPEM(t)
+
+	_, err := NewClient("https://ct.example.com", "app_1_test", TransportConfig{CACertPEM: "not a certificate"})
+	assert.Error(t, err)
+
+	_, err = NewClient("https://ct.example.com", "app_1_test", TransportConfig{ClientCertPEM: certPEM})
+	assert.Error(t, err)
+
+	_, err = NewClient("https://ct.example.com", "app_1_test", TransportConfig{ClientCertPEM: certPEM, ClientKeyPEM: "bad"})
+	assert.Error(t, err)
+
+	_, err = NewClient("https://ct.example.com", "app_1_test", TransportConfig{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM})
+	assert.NoError(t, err)
+
+	_, err = NewClient("https://ct.example.com", "app_1_test", TransportConfig{ProxyURL: "proxy.example.com"})
+	assert.Error(t, err)
+}
+
+func TestTransportProxy(t *testing.T) {
+	var host string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxy receives the full URL of the destination.
+		host = r.URL.Host
+		w.Write([]byte(`{"status":200}`))
+	}))
+	defer proxy.Close()
+
+	c, err := NewClient("http://cloudtamer.example.com", "app_1_test", TransportConfig{ProxyURL: proxy.URL})
+	assert.NoError(t, err)
+	assert.NoError(t, c.GET(context.Background(), "/v3/ou", nil))
+	assert.Equal(t, "cloudtamer.example.com", host)
+}
@@ -0,0 +1,302 @@
+package cloudtamerio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultProfile is used when no profile is specified and the config file
+// has a section with this name.
+const defaultProfile = "default"
+
+// profileFields are the provider arguments that can be set in a profile.
+var profileFields = []string{
+	"url",
+	"apikey",
+	"apikey_file",
+	"apikey_command",
+	"username",
+	"password",
+	"idms_id",
+	"skipsslvalidation",
+	"ca_cert_file",
+	"client_cert",
+	"client_key",
+	"proxy_url",
+	"max_retries",
+	"retry_min_wait",
+	"retry_max_wait",
+	"rate_limit",
+	"rate_limit_burst",
+	"max_concurrent_requests",
+}
+
+// credentialFields are taken from the profile as a group. If any of them are
+// set in the provider configuration, none are taken from the profile so the
+// sources of credentials don't conflict.
+var credentialFields = map[string]bool{
+	"apikey":         true,
+	"apikey_file":    true,
+	"apikey_command": true,
+	"username":       true,
+	"password":       true,
+	"saml_token":     true,
+	"idms_id":        true,
+}
+
+// pathFields can start with '~/' in a profile.
+var pathFields = map[string]bool{
+	"apikey_file":  true,
+	"ca_cert_file": true,
+	"client_cert":  true,
+	"client_key":   true,
+}
+
+// providerDefaults are applied after the profile for arguments that are still
+// not set. They can't be schema defaults because then a value from the
+// profile would never be used.
+var providerDefaults = map[string]interface{}{
+	"idms_id":                 1,
+	"max_retries":             ctclient.DefaultMaxRetries,
+	"retry_min_wait":          int(ctclient.DefaultRetryMinWait / time.Second),
+	"retry_max_wait":          int(ctclient.DefaultRetryMaxWait / time.Second),
+	"rate_limit":              0.0,
+	"rate_limit_burst":        1,
+	"max_concurrent_requests": 0,
+}
+
+// defaultConfigFile returns the path to: ~/.cloudtamerio/config
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".cloudtamerio", "config")
+}
+
+// expandHome replaces a leading '~/' with the home directory.
+func expandHome(p string) string {
+	if !strings.HasPrefix(p, "~/") {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+
+	return filepath.Join(home, p[2:])
+}
+
+// parseConfigFile parses an INI file of profiles like:
+//
+//	[default]
+//	url    = https://cloudtamerio.example.com
+//	apikey = app_1_XXXXXXXXXXXX
+//
+//	[profile staging]
+//	url         = https://staging.cloudtamerio.example.com
+//	apikey_file = ~/.cloudtamerio/staging-key
+//
+// The 'profile ' prefix on a section name is optional. Lines starting with
+// '#' or ';' are comments.
+func parseConfigFile(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid section: %s", n, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			name = strings.TrimSpace(strings.TrimPrefix(name, "profile "))
+			if name == "" {
+				return nil, fmt.Errorf("line %d: missing profile name", n)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = make(map[string]string)
+			}
+			current = profiles[name]
+			continue
+		}
+
+		i := strings.Index(line, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: expected 'key = value': %s", n, line)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: setting is not in a profile section", n)
+		}
+
+		key := strings.TrimSpace(line[:i])
+		value := strings.TrimSpace(line[i+1:])
+		if len(value) >= 2 && (value[0] == '"' && value[len(value)-1] == '"' || value[0] == '\'' && value[len(value)-1] == '\'') {
+			value = value[1 : len(value)-1]
+		}
+		current[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// applyProfile fills in the provider arguments that are not set in the
+// configuration or environment variables from the selected profile, then
+// applies the defaults. The order of precedence is:
+//
+//  1. Arguments in the provider block.
+//  2. Environment variables.
+//  3. The profile from 'profile' or CLOUDTAMERIO_PROFILE, or the 'default'
+//     profile if neither is set.
+//  4. The provider defaults.
+func applyProfile(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	profile, err := loadProfile(d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Unable to load cloudtamer.io profile",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("profile"),
+		})
+		return diags
+	}
+
+	// Skip the credentials in the profile if any are set directly.
+	skipCredentials := false
+	for field := range credentialFields {
+		if field == "idms_id" || field == "password" {
+			continue
+		}
+		if _, ok := d.GetOkExists(field); ok {
+			skipCredentials = true
+		}
+	}
+
+	for _, field := range profileFields {
+		raw, ok := profile[field]
+		if !ok {
+			continue
+		} else if _, ok := d.GetOkExists(field); ok {
+			continue
+		} else if credentialFields[field] && skipCredentials {
+			continue
+		}
+
+		if pathFields[field] {
+			raw = expandHome(raw)
+		}
+
+		v, err := parseProfileValue(Provider().Schema[field].Type, raw)
+		if err == nil {
+			err = d.Set(field, v)
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid value in cloudtamer.io profile",
+				Detail:        fmt.Sprintf("Error: %v\nItem: %v", err.Error(), field),
+				AttributePath: cty.GetAttrPath("profile"),
+			})
+			return diags
+		}
+	}
+
+	for field, v := range providerDefaults {
+		if _, ok := d.GetOkExists(field); !ok {
+			if err := d.Set(field, v); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// loadProfile returns the settings from the selected profile. It returns an
+// empty map if no profile is selected and there is no default profile.
+func loadProfile(d *schema.ResourceData) (map[string]string, error) {
+	name := d.Get("profile").(string)
+
+	path := d.Get("config_file").(string)
+	if path == "" {
+		path = defaultConfigFile()
+	}
+	path = expandHome(path)
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) && name == "" {
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not open the config file for profile '%s': %v", name, err)
+	}
+	defer f.Close()
+
+	profiles, err := parseConfigFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", path, err)
+	}
+
+	if name == "" {
+		name = defaultProfile
+		if _, ok := profiles[name]; !ok {
+			return map[string]string{}, nil
+		}
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile '%s' is not in %s", name, path)
+	}
+
+	allowed := make(map[string]bool)
+	for _, field := range profileFields {
+		allowed[field] = true
+	}
+	for key := range profile {
+		if !allowed[key] {
+			return nil, fmt.Errorf("unsupported setting '%s' in profile '%s'", key, name)
+		}
+	}
+
+	return profile, nil
+}
+
+// parseProfileValue converts a string from the config file to the type of the
+// provider argument.
+func parseProfileValue(t schema.ValueType, raw string) (interface{}, error) {
+	switch t {
+	case schema.TypeBool:
+		return strconv.ParseBool(raw)
+	case schema.TypeInt:
+		return strconv.Atoi(raw)
+	case schema.TypeFloat:
+		return strconv.ParseFloat(raw, 64)
+	}
+
+	return raw, nil
+}
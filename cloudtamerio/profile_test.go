@@ -0,0 +1,188 @@
+package cloudtamerio
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigFile = `
+# Shared settings for cloudtamer.io.
+[default]
+url    = https://default.example.com
+apikey = app_1_default
+
+[profile staging]
+url         = "https://staging.example.com"
+apikey_file = /tmp/staging-key
+max_retries = 5
+rate_limit  = 2.5
+
+; The 'profile ' prefix is optional.
+[prod]
+url      = https://prod.example.com
+username = terraform
+password = secret
+idms_id  = 2
+`
+
+// clearProviderEnv unsets the provider environment variables for the test so
+// they don't affect the results.
+func clearProviderEnv() func() {
+	unset := func() map[string]string {
+		saved := make(map[string]string)
+		for _, kv := range os.Environ() {
+			if strings.HasPrefix(kv, "CLOUDTAMERIO_") {
+				parts := strings.SplitN(kv, "=", 2)
+				saved[parts[0]] = parts[1]
+				os.Unsetenv(parts[0])
+			}
+		}
+		return saved
+	}
+	saved := unset()
+
+	return func() {
+		unset()
+		for k, v := range saved {
+			os.Setenv(k, v)
+		}
+	}
+}
+
+// newProfileData writes the config file to dir and returns provider data
+// that reads it.
+func newProfileData(t *testing.T, dir string, config string, raw map[string]interface{}) *schema.ResourceData {
+	path := filepath.Join(dir, "config")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(config), 0600))
+
+	raw["config_file"] = path
+	return schema.TestResourceDataRaw(t, Provider().Schema, raw)
+}
+
+func TestParseConfigFile(t *testing.T) {
+	profiles, err := parseConfigFile(strings.NewReader(testConfigFile))
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 3)
+	assert.Equal(t, "https://staging.example.com", profiles["staging"]["url"])
+	assert.Equal(t, "2", profiles["prod"]["idms_id"])
+
+	_, err = parseConfigFile(strings.NewReader("url = https://example.com"))
+	assert.EqualError(t, err, "line 1: setting is not in a profile section")
+
+	_, err = parseConfigFile(strings.NewReader("[default]\nurl"))
+	assert.EqualError(t, err, "line 2: expected 'key = value': url")
+
+	_, err = parseConfigFile(strings.NewReader("[default"))
+	assert.EqualError(t, err, "line 1: invalid section: [default")
+}
+
+func TestApplyProfileDefault(t *testing.T) {
+	defer clearProviderEnv()()
+
+	dir, err := ioutil.TempDir("", "cloudtamerio")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	d := newProfileData(t, dir, testConfigFile, map[string]interface{}{})
+	assert.False(t, applyProfile(d).HasError())
+	assert.Equal(t, "https://default.example.com", d.Get("url"))
+	assert.Equal(t, "app_1_default", d.Get("apikey"))
+	assert.Equal(t, 3, d.Get("max_retries"))
+	assert.Equal(t, 1, d.Get("idms_id"))
+}
+
+func TestApplyProfilePrecedence(t *testing.T) {
+	defer clearProviderEnv()()
+
+	dir, err := ioutil.TempDir("", "cloudtamerio")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Arguments take precedence over the profile.
+	d := newProfileData(t, dir, testConfigFile, map[string]interface{}{
+		"profile":     "staging",
+		"max_retries": 0,
+	})
+	assert.False(t, applyProfile(d).HasError())
+	assert.Equal(t, "https://staging.example.com", d.Get("url"))
+	assert.Equal(t, "/tmp/staging-key", d.Get("apikey_file"))
+	assert.Equal(t, 0, d.Get("max_retries"))
+	assert.Equal(t, 2.5, d.Get("rate_limit"))
+	assert.Equal(t, 30, d.Get("retry_max_wait"))
+
+	// Environment variables take precedence over the profile.
+	os.Setenv("CLOUDTAMERIO_PROFILE", "staging")
+	os.Setenv("CLOUDTAMERIO_URL", "https://env.example.com")
+	d = newProfileData(t, dir, testConfigFile, map[string]interface{}{})
+	assert.False(t, applyProfile(d).HasError())
+	assert.Equal(t, "https://env.example.com", d.Get("url"))
+	assert.Equal(t, 5, d.Get("max_retries"))
+}
+
+func TestApplyProfileCredentials(t *testing.T) {
+	defer clearProviderEnv()()
+
+	dir, err := ioutil.TempDir("", "cloudtamerio")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	d := newProfileData(t, dir, testConfigFile, map[string]interface{}{"profile": "prod"})
+	assert.False(t, applyProfile(d).HasError())
+	assert.Equal(t, "terraform", d.Get("username"))
+	assert.Equal(t, "secret", d.Get("password"))
+	assert.Equal(t, 2, d.Get("idms_id"))
+
+	// Credentials in the configuration replace all of the credentials in the
+	// profile.
+	d = newProfileData(t, dir, testConfigFile, map[string]interface{}{
+		"profile": "prod",
+		"apikey":  "app_1_config",
+	})
+	assert.False(t, applyProfile(d).HasError())
+	assert.Equal(t, "https://prod.example.com", d.Get("url"))
+	assert.Equal(t, "app_1_config", d.Get("apikey"))
+	assert.Equal(t, "", d.Get("username"))
+	assert.Equal(t, "", d.Get("password"))
+	assert.Equal(t, 1, d.Get("idms_id"))
+}
+
+func TestApplyProfileErrors(t *testing.T) {
+	defer clearProviderEnv()()
+
+	dir, err := ioutil.TempDir("", "cloudtamerio")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	d := newProfileData(t, dir, testConfigFile, map[string]interface{}{"profile": "missing"})
+	diags := applyProfile(d)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "profile 'missing' is not in")
+
+	d = newProfileData(t, dir, "[default]\nregion = us-east-1", map[string]interface{}{})
+	diags = applyProfile(d)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "unsupported setting 'region'")
+
+	d = newProfileData(t, dir, "[default]\nmax_retries = many", map[string]interface{}{})
+	diags = applyProfile(d)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Invalid value in cloudtamer.io profile", diags[0].Summary)
+
+	// A missing config file is only an error if a profile is selected.
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"config_file": filepath.Join(dir, "does-not-exist"),
+	})
+	assert.False(t, applyProfile(d).HasError())
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"config_file": filepath.Join(dir, "does-not-exist"),
+		"profile":     "staging",
+	})
+	assert.True(t, applyProfile(d).HasError())
+}
@@ -3,8 +3,16 @@ package cloudtamerio
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,69 +22,242 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"url": {
-				Description: "The URL of a cloudtamer.io installation. Example: https://cloudtamerio.example.com.",
+				Description: "The URL of a cloudtamer.io installation. Example: https://cloudtamerio.example.com. Do not include '/api' at the end. Required unless it is set in the profile.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_URL", nil),
 			},
+			"profile": {
+				Description: "The name of a profile in the config file to read settings from. Arguments and environment variables take precedence over the profile. Defaults to the 'default' profile if the config file has one.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_PROFILE", nil),
+			},
+			"config_file": {
+				Description: "Path to the config file with the profiles. Defaults to ~/.cloudtamerio/config.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_CONFIG_FILE", nil),
+			},
 			"apikey": {
-				Description: "The API key generated from cloudtamer.io. Example: app_1_XXXXXXXXXXXX.",
+				Description: "The API key generated from cloudtamer.io. Example: app_1_XXXXXXXXXXXX. Exactly one of 'apikey', 'apikey_file', 'apikey_command', 'username', or 'saml_token' must be set.",
 				Type:        schema.TypeString,
 				Sensitive:   true,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_APIKEY", nil),
 			},
+			"apikey_file": {
+				Description: "Path to a file that contains the API key. The file is read again if the key is rejected so it can be rotated.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_APIKEY_FILE", nil),
+			},
+			"apikey_command": {
+				Description: "A command that prints the API key to stdout, like a credential helper. The command is run through the shell and is run again if the key is rejected.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_APIKEY_COMMAND", nil),
+			},
+			"username": {
+				Description: "The username to log in with instead of an API key. The login is exchanged for a short-lived token that is renewed automatically. Requires 'password'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_USERNAME", nil),
+			},
+			"password": {
+				Description: "The password for 'username'.",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_PASSWORD", nil),
+			},
+			"saml_token": {
+				Description: "A SAML token from the identity provider to log in with instead of an API key. The login is exchanged for a short-lived token.",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_SAML_TOKEN", nil),
+			},
+			"idms_id": {
+				Description: "The ID of the identity management system used by 'username' or 'saml_token'. Defaults to 1, the local IDMS.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_IDMS_ID", nil),
+			},
 			"skipsslvalidation": {
 				Description: "If true, will skip SSL validation.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_SKIPSSLVALIDATION", nil),
 			},
+			"ca_cert_file": {
+				Description: "Path to a PEM-encoded CA certificate bundle used to verify the cloudtamer.io server certificate. The system certificates are still trusted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_CA_CERT_FILE", nil),
+			},
+			"ca_cert_pem": {
+				Description: "PEM-encoded CA certificate bundle used to verify the cloudtamer.io server certificate. The system certificates are still trusted.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_CA_CERT_PEM", nil),
+			},
+			"client_cert": {
+				Description: "PEM-encoded client certificate, or a path to one, used for mutual TLS. Requires 'client_key'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_CLIENT_CERT", nil),
+			},
+			"client_key": {
+				Description: "PEM-encoded client private key, or a path to one, used for mutual TLS. Requires 'client_cert'.",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_CLIENT_KEY", nil),
+			},
+			"proxy_url": {
+				Description: "URL of an HTTP proxy used to reach cloudtamer.io. Example: http://proxy.example.com:3128. Defaults to the HTTPS_PROXY and HTTP_PROXY environment variables.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_PROXY_URL", nil),
+			},
+			"max_retries": {
+				Description: "The number of times to retry a request that failed because of a connection error or a 429, 502, 503, or 504 response. Set to 0 to disable retries. Defaults to 3.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_MAX_RETRIES", nil),
+			},
+			"retry_min_wait": {
+				Description: "The minimum number of seconds to wait before retrying a request. Defaults to 1.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_RETRY_MIN_WAIT", nil),
+			},
+			"retry_max_wait": {
+				Description: "The maximum number of seconds to wait before retrying a request. Defaults to 30.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_RETRY_MAX_WAIT", nil),
+			},
+			"rate_limit": {
+				Description: "The maximum number of requests per second sent to cloudtamer.io, shared across all resources. Set to 0 to disable the limit. Defaults to 0.",
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_RATE_LIMIT", nil),
+			},
+			"rate_limit_burst": {
+				Description: "The number of requests that can be sent at once before 'rate_limit' applies. Defaults to 1.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_RATE_LIMIT_BURST", nil),
+			},
+			"max_concurrent_requests": {
+				Description: "The maximum number of requests that can be in flight to cloudtamer.io at the same time, regardless of Terraform's parallelism. Set to 0 to disable the limit. Defaults to 0.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CLOUDTAMERIO_MAX_CONCURRENT_REQUESTS", nil),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"cloudtamerio_aws_cloudformation_template": resourceAwsCloudformationTemplate(),
-			"cloudtamerio_aws_iam_policy":              resourceAwsIamPolicy(),
-			"cloudtamerio_azure_policy":                resourceAzurePolicy(),
-			"cloudtamerio_cloud_rule":                  resourceCloudRule(),
-			"cloudtamerio_compliance_check":            resourceComplianceCheck(),
-			"cloudtamerio_compliance_standard":         resourceComplianceStandard(),
-			"cloudtamerio_ou_cloud_access_role":        resourceOUCloudAccessRole(),
-			"cloudtamerio_project_cloud_access_role":   resourceProjectCloudAccessRole(),
-			"cloudtamerio_ou":                          resourceOU(),
-			"cloudtamerio_user_group":                  resourceUserGroup(),
-			"cloudtamerio_saml_group_association":      resourceSamlGroupAssociation(),
-			"cloudtamerio_project":                     resourceProject(),
-			"cloudtamerio_gcp_iam_role":                resourceGcpIamRole(),
-			"cloudtamerio_service_control_policy":      resourceServiceControlPolicy(),
-			"cloudtamerio_azure_arm_template":          resourceAzureArmTemplate(),
-			"cloudtamerio_azure_role":                  resourceAzureRole(),
+			"cloudtamerio_app_api_key":                    resourceAppAPIKey(),
+			"cloudtamerio_aws_account":                    resourceAwsAccount(),
+			"cloudtamerio_aws_ami":                        resourceAwsAmi(),
+			"cloudtamerio_aws_cloudformation_template":    resourceAwsCloudformationTemplate(),
+			"cloudtamerio_aws_iam_policy":                 resourceAwsIamPolicy(),
+			"cloudtamerio_aws_service_catalog_portfolio":  resourceAwsServiceCatalogPortfolio(),
+			"cloudtamerio_azure_policy":                   resourceAzurePolicy(),
+			"cloudtamerio_cloud_rule":                     resourceCloudRule(),
+			"cloudtamerio_compliance_check":               resourceComplianceCheck(),
+			"cloudtamerio_compliance_exemption":           resourceComplianceExemption(),
+			"cloudtamerio_compliance_standard":            resourceComplianceStandard(),
+			"cloudtamerio_compliance_standard_attachment": resourceComplianceStandardAttachment(),
+			"cloudtamerio_funding_source":                 resourceFundingSource(),
+			"cloudtamerio_idms_ldap":                      resourceIdmsLdap(),
+			"cloudtamerio_idms_saml":                      resourceIdmsSaml(),
+			"cloudtamerio_label":                          resourceLabel(),
+			"cloudtamerio_ou_cloud_access_role":           resourceOUCloudAccessRole(),
+			"cloudtamerio_ou_permission_mapping":          resourceOUPermissionMapping(),
+			"cloudtamerio_project_cloud_access_role":      resourceProjectCloudAccessRole(),
+			"cloudtamerio_project_permission_mapping":     resourceProjectPermissionMapping(),
+			"cloudtamerio_ou":                             resourceOU(),
+			"cloudtamerio_user":                           resourceUser(),
+			"cloudtamerio_user_group":                     resourceUserGroup(),
+			"cloudtamerio_webhook":                        resourceWebhook(),
+			"cloudtamerio_saml_group_association":         resourceSamlGroupAssociation(),
+			"cloudtamerio_project":                        resourceProject(),
+			"cloudtamerio_project_funding":                resourceProjectFunding(),
+			"cloudtamerio_gcp_iam_role":                   resourceGcpIamRole(),
+			"cloudtamerio_service_control_policy":         resourceServiceControlPolicy(),
+			"cloudtamerio_azure_arm_template":             resourceAzureArmTemplate(),
+			"cloudtamerio_azure_role":                     resourceAzureRole(),
+			"cloudtamerio_azure_subscription":             resourceAzureSubscription(),
+			"cloudtamerio_budget_enforcement":             resourceBudgetEnforcement(),
+			"cloudtamerio_gcp_project":                    resourceGcpProject(),
+			"cloudtamerio_spend_plan":                     resourceSpendPlan(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"cloudtamerio_aws_cloudformation_template": dataSourceAwsCloudformationTemplate(),
-			"cloudtamerio_aws_iam_policy":              dataSourceAwsIamPolicy(),
-			"cloudtamerio_azure_policy":                dataSourceAzurePolicy(),
-			"cloudtamerio_cloud_rule":                  dataSourceCloudRule(),
-			"cloudtamerio_compliance_check":            dataSourceComplianceCheck(),
-			"cloudtamerio_compliance_standard":         dataSourceComplianceStandard(),
-			"cloudtamerio_ou":                          dataSourceOU(),
-			"cloudtamerio_user_group":                  dataSourceUserGroup(),
-			"cloudtamerio_saml_group_association":      dataSourceSamlGroupAssociation(),
-			"cloudtamerio_project":                     dataSourceProject(),
-			"cloudtamerio_gcp_iam_role":                dataSourceGcpIamRole(),
-			"cloudtamerio_service_control_policy":      dataServiceControlPolicy(),
-			"cloudtamerio_azure_arm_template":          dataSourceAzureArmTemplate(),
-			"cloudtamerio_azure_role":                  dataSourceAzureRole(),
+			"cloudtamerio_aws_account":                   dataSourceAwsAccount(),
+			"cloudtamerio_aws_ami":                       dataSourceAwsAmi(),
+			"cloudtamerio_aws_cloudformation_template":   dataSourceAwsCloudformationTemplate(),
+			"cloudtamerio_aws_iam_policy":                dataSourceAwsIamPolicy(),
+			"cloudtamerio_aws_service_catalog_portfolio": dataSourceAwsServiceCatalogPortfolio(),
+			"cloudtamerio_azure_policy":                  dataSourceAzurePolicy(),
+			"cloudtamerio_cloud_rule":                    dataSourceCloudRule(),
+			"cloudtamerio_compliance_check":              dataSourceComplianceCheck(),
+			"cloudtamerio_compliance_findings":           dataSourceComplianceFindings(),
+			"cloudtamerio_compliance_scan":               dataSourceComplianceScan(),
+			"cloudtamerio_compliance_standard":           dataSourceComplianceStandard(),
+			"cloudtamerio_funding_source":                dataSourceFundingSource(),
+			"cloudtamerio_idms":                          dataSourceIdms(),
+			"cloudtamerio_label":                         dataSourceLabel(),
+			"cloudtamerio_ou":                            dataSourceOU(),
+			"cloudtamerio_permission_scheme":             dataSourcePermissionScheme(),
+			"cloudtamerio_user":                          dataSourceUser(),
+			"cloudtamerio_user_group":                    dataSourceUserGroup(),
+			"cloudtamerio_webhook":                       dataSourceWebhook(),
+			"cloudtamerio_saml_group_association":        dataSourceSamlGroupAssociation(),
+			"cloudtamerio_project":                       dataSourceProject(),
+			"cloudtamerio_gcp_iam_role":                  dataSourceGcpIamRole(),
+			"cloudtamerio_service_control_policy":        dataServiceControlPolicy(),
+			"cloudtamerio_azure_arm_template":            dataSourceAzureArmTemplate(),
+			"cloudtamerio_azure_role":                    dataSourceAzureRole(),
+			"cloudtamerio_azure_subscription":            dataSourceAzureSubscription(),
+			"cloudtamerio_budget_enforcement":            dataSourceBudgetEnforcement(),
+			"cloudtamerio_gcp_project":                   dataSourceGcpProject(),
+			"cloudtamerio_spend_plan":                    dataSourceSpendPlan(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	diags = append(diags, applyProfile(d)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	ctURL := d.Get("url").(string)
 	ctAPIKey := d.Get("apikey").(string)
+	if ctURL == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Missing cloudtamer.io URL",
+			Detail:        "The 'url' value must be set in the provider configuration, the CLOUDTAMERIO_URL environment variable, or the profile.",
+			AttributePath: cty.GetAttrPath("url"),
+		})
+		return nil, diags
+	}
 
-	// Warning or errors can be collected in a slice type
-	var diags diag.Diagnostics
+	diags = append(diags, validateURL(ctURL)...)
+	if ctAPIKey != "" {
+		diags = append(diags, validateAPIKey(ctAPIKey)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
 
 	var skipSSLValidation bool
 	v, ok := d.GetOk("skipsslvalidation")
@@ -85,17 +266,257 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		skipSSLValidation = t
 	}
 
-	c := ctclient.NewClient(ctURL, ctAPIKey, skipSSLValidation)
-	err := c.GET("/v3/me/cloud-access-role", nil)
+	tc := ctclient.TransportConfig{
+		SkipSSLValidation: skipSSLValidation,
+		CACertPEM:         d.Get("ca_cert_pem").(string),
+		ProxyURL:          d.Get("proxy_url").(string),
+	}
+
+	if v, ok := d.GetOk("ca_cert_file"); ok {
+		b, err := ioutil.ReadFile(v.(string))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Unable to read CA certificate file",
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("ca_cert_file"),
+			})
+			return nil, diags
+		}
+		tc.CACertPEM += "\n" + string(b)
+	}
+
+	for _, field := range []string{"client_cert", "client_key"} {
+		pem, err := readPEMOrFile(d.Get(field).(string))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Unable to read " + field,
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath(field),
+			})
+			return nil, diags
+		}
+		if field == "client_cert" {
+			tc.ClientCertPEM = pem
+		} else {
+			tc.ClientKeyPEM = pem
+		}
+	}
+
+	c, err := ctclient.NewClient(ctURL, ctAPIKey, tc)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to create cloudtamer.io client",
-			Detail:   "Unable to authenticate - " + err.Error(),
+			Detail:   err.Error(),
+		})
+		return nil, diags
+	}
+
+	diags = append(diags, configureAuth(d, c)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	c.MaxRetries = d.Get("max_retries").(int)
+	c.RetryMinWait = time.Duration(d.Get("retry_min_wait").(int)) * time.Second
+	c.RetryMaxWait = time.Duration(d.Get("retry_max_wait").(int)) * time.Second
+	if c.RetryMaxWait < c.RetryMinWait {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid retry configuration",
+			Detail:   "The 'retry_max_wait' value must be greater than or equal to 'retry_min_wait'.",
 		})
 
 		return nil, diags
 	}
 
+	rateLimit := d.Get("rate_limit").(float64)
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+	if rateLimit < 0 || maxConcurrentRequests < 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid rate limit configuration",
+			Detail:   "The 'rate_limit' and 'max_concurrent_requests' values must not be negative.",
+		})
+
+		return nil, diags
+	}
+	c.SetRateLimit(rateLimit, d.Get("rate_limit_burst").(int))
+	c.SetMaxConcurrentRequests(maxConcurrentRequests)
+
+	diags = append(diags, checkAuth(ctx, c)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	return c, diags
 }
+
+// apiKeyFormat matches an app API key like: app_1_XXXXXXXXXXXX
+var apiKeyFormat = regexp.MustCompile(`^app_[0-9]+_[A-Za-z0-9]+$`)
+
+// validateURL checks the URL is the base URL of a cloudtamer.io installation.
+func validateURL(ctURL string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	invalid := func(detail string) diag.Diagnostics {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid cloudtamer.io URL",
+			Detail:        detail,
+			AttributePath: cty.GetAttrPath("url"),
+		})
+	}
+
+	u, err := url.Parse(ctURL)
+	if err != nil {
+		return invalid(fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ctURL))
+	} else if u.Scheme != "https" && u.Scheme != "http" {
+		return invalid(fmt.Sprintf("The URL must start with https:// or http://. Example: https://cloudtamerio.example.com\nItem: %v", ctURL))
+	} else if u.Host == "" {
+		return invalid(fmt.Sprintf("The URL must include the host name of the cloudtamer.io installation. Example: https://cloudtamerio.example.com\nItem: %v", ctURL))
+	} else if strings.HasSuffix(strings.TrimSuffix(u.Path, "/"), "/api") {
+		return invalid(fmt.Sprintf("Remove '/api' from the end of the URL, the provider adds it to each request.\nItem: %v", ctURL))
+	}
+
+	return diags
+}
+
+// validateAPIKey checks the format of the API key without including the key
+// in the error.
+func validateAPIKey(apiKey string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !apiKeyFormat.MatchString(strings.TrimSpace(apiKey)) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid cloudtamer.io API key",
+			Detail:        "The API key must be an app API key generated from cloudtamer.io. Example: app_1_XXXXXXXXXXXX",
+			AttributePath: cty.GetAttrPath("apikey"),
+		})
+	}
+
+	return diags
+}
+
+// checkAuth verifies the credentials against cloudtamer.io and logs the
+// version of cloudtamer.io and the user the provider authenticated as.
+func checkAuth(ctx context.Context, c *ctclient.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := c.GET(ctx, "/v3/me/cloud-access-role", nil)
+	if err != nil {
+		detail := "Unable to authenticate - " + err.Error()
+		if ctclient.IsUnauthorized(err) {
+			detail += "\nCheck the credentials are valid and have not expired or been revoked."
+		} else if ctclient.IsForbidden(err) {
+			detail += "\nThe credentials are valid, but the user is not allowed to use the API."
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create cloudtamer.io client",
+			Detail:   detail,
+		})
+
+		return diags
+	}
+
+	// The version and identity are only used for logging so they don't need
+	// to succeed.
+	identity := "an unknown user"
+	me := ctclient.MeResponse{}
+	if err := c.GET(ctx, "/v3/me", &me); err != nil {
+		log.Printf("[WARN] Unable to look up the cloudtamer.io user: %v", err)
+	} else {
+		identity = fmt.Sprintf("user %s (ID %d)", me.Data.Username, me.Data.ID)
+	}
+
+	version := "an unknown version"
+	v := ctclient.VersionResponse{}
+	if err := c.GET(ctx, "/v3/version", &v); err != nil {
+		log.Printf("[WARN] Unable to look up the cloudtamer.io version: %v", err)
+	} else if v.Data.Version != "" {
+		version = "version " + v.Data.Version
+	}
+
+	log.Printf("[INFO] Authenticated to cloudtamer.io %s at %s as %s", version, c.HostURL, identity)
+
+	return diags
+}
+
+// readPEMOrFile returns the value if it is PEM-encoded, otherwise it treats
+// the value as a path and returns the contents of the file.
+func readPEMOrFile(v string) (string, error) {
+	if v == "" || strings.Contains(v, "-----BEGIN") {
+		return v, nil
+	}
+
+	b, err := ioutil.ReadFile(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// configureAuth sets the token source on the client from the credentials in
+// the provider configuration. Exactly one source of credentials is allowed.
+func configureAuth(d *schema.ResourceData, c *ctclient.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sources := make([]string, 0)
+	for _, field := range []string{"apikey", "apikey_file", "apikey_command", "username", "saml_token"} {
+		if _, ok := d.GetOk(field); ok {
+			sources = append(sources, field)
+		}
+	}
+
+	if len(sources) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Missing cloudtamer.io credentials",
+			Detail:   "One of 'apikey', 'apikey_file', 'apikey_command', 'username', or 'saml_token' must be set.",
+		})
+		return diags
+	} else if len(sources) > 1 {
+		for _, field := range sources {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Conflicting cloudtamer.io credentials",
+				Detail:        fmt.Sprintf("Only one of these can be set: %v.", strings.Join(sources, ", ")),
+				AttributePath: cty.GetAttrPath(field),
+			})
+		}
+		return diags
+	}
+
+	switch sources[0] {
+	case "apikey_file":
+		c.TokenSource = &ctclient.FileTokenSource{Path: d.Get("apikey_file").(string)}
+	case "apikey_command":
+		c.TokenSource = &ctclient.CommandTokenSource{Command: d.Get("apikey_command").(string)}
+	case "username":
+		if _, ok := d.GetOk("password"); !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Missing password",
+				Detail:        "The 'password' value is required when 'username' is set.",
+				AttributePath: cty.GetAttrPath("password"),
+			})
+			return diags
+		}
+		c.TokenSource = ctclient.NewLoginTokenSource(c, ctclient.Login{
+			IdmsID:   d.Get("idms_id").(int),
+			Username: d.Get("username").(string),
+			Password: d.Get("password").(string),
+		})
+	case "saml_token":
+		c.TokenSource = ctclient.NewLoginTokenSource(c, ctclient.Login{
+			IdmsID:    d.Get("idms_id").(int),
+			SAMLToken: d.Get("saml_token").(string),
+		})
+	}
+
+	return diags
+}
@@ -1,10 +1,15 @@
 package cloudtamerio
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -27,6 +32,67 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestValidateURL(t *testing.T) {
+	assert.False(t, validateURL("https://cloudtamerio.example.com").HasError())
+	assert.False(t, validateURL("http://localhost:8081/cloudtamer/").HasError())
+
+	for _, v := range []string{
+		"cloudtamerio.example.com",
+		"ftp://cloudtamerio.example.com",
+		"https://",
+		"https://cloudtamerio.example.com/api",
+		"https://cloudtamerio.example.com/api/",
+		"https://cloudtamerio.example.com/%zz",
+	} {
+		diags := validateURL(v)
+		if assert.True(t, diags.HasError(), v) {
+			assert.Equal(t, "Invalid cloudtamer.io URL", diags[0].Summary)
+		}
+	}
+}
+
+func TestValidateAPIKey(t *testing.T) {
+	assert.False(t, validateAPIKey("app_1_XXXXXXXXXXXX").HasError())
+	assert.False(t, validateAPIKey("app_12_abc123\n").HasError())
+
+	for _, v := range []string{"secret123", "app_x_secret", "app_7_", "Bearer app_7_secret"} {
+		diags := validateAPIKey(v)
+		if assert.True(t, diags.HasError(), v) {
+			assert.NotContains(t, diags[0].Detail, v)
+		}
+	}
+}
+
+func TestCheckAuth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer app_1_valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":401,"message":"Invalid API key."}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v3/me":
+			w.Write([]byte(`{"status":200,"data":{"id":1,"username":"admin"}}`))
+		case "/api/v3/version":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"status":200,"data":[]}`))
+		}
+	}))
+	defer ts.Close()
+
+	c, err := ctclient.NewClient(ts.URL, "app_1_valid", ctclient.TransportConfig{})
+	assert.NoError(t, err)
+	assert.False(t, checkAuth(context.Background(), c).HasError())
+
+	c, err = ctclient.NewClient(ts.URL, "app_1_invalid", ctclient.TransportConfig{})
+	assert.NoError(t, err)
+	diags := checkAuth(context.Background(), c)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail, "Invalid API key.")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if err := os.Getenv("CLOUDTAMERIO_URL"); err == "" {
 		t.Fatal("CLOUDTAMERIO_URL must be set for acceptance tests")
@@ -0,0 +1,206 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAppAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppAPIKeyCreate,
+		ReadContext:   resourceAppAPIKeyRead,
+		UpdateContext: resourceAppAPIKeyUpdate,
+		DeleteContext: resourceAppAPIKeyDelete,
+		Importer:      importWithRead("cloudtamerio_app_api_key", resourceAppAPIKeyRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true, // Not allowed to be changed, forces new item if changed.
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+		},
+	}
+}
+
+func resourceAppAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.AppAPIKeyCreate{
+		ExpiresAt: hc.FlattenStringPointer(d, "expires_at"),
+		Name:      d.Get("name").(string),
+		UserID:    hc.FlattenIntPointer(d, "user_id"),
+	}
+
+	resp := new(hc.AppAPIKeyCreateResponse)
+	err := c.POSTWithResponse(ctx, "/v3/app-api-key", post, resp)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create App API Key",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Name),
+		})
+		return diags
+	} else if resp.Data.ID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create App API Key",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Name),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.Data.ID))
+
+	// The key is only returned when it is created so it is kept in state.
+	d.Set("key", resp.Data.Key)
+
+	return resourceAppAPIKeyRead(ctx, d, m)
+}
+
+func resourceAppAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.AppAPIKeyResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/app-api-key/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] App API Key %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read App API Key",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["created_at"] = item.CreatedAt
+	data["expires_at"] = item.ExpiresAt
+	data["name"] = item.Name
+	data["user_id"] = item.UserID
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set App API Key",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceAppAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `expires_at` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("name") {
+		hasChanged++
+		req := hc.AppAPIKeyUpdate{
+			Name: d.Get("name").(string),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/app-api-key/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update App API Key",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceAppAPIKeyRead(ctx, d, m)
+}
+
+func resourceAppAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/app-api-key/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete App API Key",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
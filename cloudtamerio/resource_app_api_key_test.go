@@ -0,0 +1,61 @@
+package cloudtamerio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppAPIKeyCreate(t *testing.T) {
+	var posted string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+			posted = string(body)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"status":201,"data":{"id":9,"key":"app_9_secret"}}`))
+			return
+		}
+		// The key is never returned after it is created.
+		w.Write([]byte(`{"status":200,"data":{"id":9,"name":"ci","user_id":3,"created_at":"2021-12-01T00:00:00Z","expires_at":"2030-01-01T00:00:00Z"}}`))
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceAppAPIKey().Schema, map[string]interface{}{
+		"expires_at": "2030-01-01T00:00:00Z",
+		"name":       "ci",
+		"user_id":    3,
+	})
+	assert.False(t, resourceAppAPIKeyCreate(context.Background(), d, c).HasError())
+	assert.Equal(t, `{"expires_at":"2030-01-01T00:00:00Z","name":"ci","user_id":3}`, posted)
+	assert.Equal(t, "9", d.Id())
+	assert.Equal(t, "app_9_secret", d.Get("key"))
+	assert.Equal(t, "2021-12-01T00:00:00Z", d.Get("created_at"))
+}
+
+func TestAppAPIKeyDefaultExpiry(t *testing.T) {
+	// The expiry assigned by cloudtamer.io does not replace the key when
+	// expires_at is not set.
+	state := &terraform.InstanceState{
+		ID: "9",
+		Attributes: map[string]string{
+			"expires_at": "2022-12-01T00:00:00Z",
+			"name":       "ci",
+		},
+	}
+	diff, err := resourceAppAPIKey().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "ci",
+	}), nil)
+	assert.NoError(t, err)
+	assert.False(t, diff != nil && diff.RequiresNew())
+}
@@ -0,0 +1,383 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsAccountCreate,
+		ReadContext:   resourceAwsAccountRead,
+		UpdateContext: resourceAwsAccountUpdate,
+		DeleteContext: resourceAwsAccountDelete,
+		Importer:      importWithRead("cloudtamerio_aws_account", resourceAwsAccountRead),
+		Timeouts: &schema.ResourceTimeout{
+			// Requesting a new account through the organization can take a while.
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true, // Not allowed to be changed, forces new item if changed.
+				ExactlyOneOf: []string{"account_number", "email"},
+			},
+			"account_type_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"include_linked_account_spend": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"labels": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"linked_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"move_datecode": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"move_financials": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "move",
+				ValidateFunc: validation.StringInSlice([]string{"move", "preserve"}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"payer_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"skip_access_checking": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"start_datecode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+		},
+	}
+}
+
+func resourceAwsAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	// An account number registers an existing account, otherwise a new
+	// account is requested through the organization of the payer.
+	if v, ok := d.GetOk("account_number"); ok {
+		post := hc.AwsAccountImport{
+			AccountAlias:              hc.FlattenStringPointer(d, "account_alias"),
+			AccountNumber:             v.(string),
+			AccountTypeID:             hc.FlattenIntPointer(d, "account_type_id"),
+			IncludeLinkedAccountSpend: d.Get("include_linked_account_spend").(bool),
+			LabelIds:                  hc.FlattenGenericIDPointer(d, "labels"),
+			LinkedRole:                hc.FlattenStringPointer(d, "linked_role"),
+			Name:                      d.Get("name").(string),
+			PayerID:                   d.Get("payer_id").(int),
+			ProjectID:                 d.Get("project_id").(int),
+			SkipAccessChecking:        d.Get("skip_access_checking").(bool),
+			StartDatecode:             hc.FlattenStringPointer(d, "start_datecode"),
+		}
+
+		resp, err := c.POST(ctx, "/v3/account?account-type=aws", post)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create AWS Account",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+			})
+			return diags
+		} else if resp.RecordID == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to create AWS Account",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+			})
+			return diags
+		}
+
+		d.SetId(strconv.Itoa(resp.RecordID))
+
+		return resourceAwsAccountRead(ctx, d, m)
+	}
+
+	post := hc.AwsAccountRequestCreate{
+		AccountAlias:              hc.FlattenStringPointer(d, "account_alias"),
+		AccountTypeID:             hc.FlattenIntPointer(d, "account_type_id"),
+		Email:                     d.Get("email").(string),
+		IncludeLinkedAccountSpend: d.Get("include_linked_account_spend").(bool),
+		LabelIds:                  hc.FlattenGenericIDPointer(d, "labels"),
+		LinkedRole:                hc.FlattenStringPointer(d, "linked_role"),
+		Name:                      d.Get("name").(string),
+		PayerID:                   d.Get("payer_id").(int),
+		ProjectID:                 d.Get("project_id").(int),
+	}
+
+	resp, err := c.POST(ctx, "/v3/account-request?account-type=aws", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to request AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to request AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+		})
+		return diags
+	}
+
+	accountID, err := waitForAwsAccountRequest(ctx, c, resp.RecordID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to request AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), resp.RecordID),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+
+	return resourceAwsAccountRead(ctx, d, m)
+}
+
+// waitForAwsAccountRequest polls the account request until the account is
+// created and returns the ID of the new account.
+func waitForAwsAccountRequest(ctx context.Context, c *hc.Client, requestID int, timeout time.Duration) (int, error) {
+	var accountID int
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		resp := new(hc.AwsAccountRequestResponse)
+		err := c.GET(ctx, fmt.Sprintf("/v3/account-request/%d", requestID), resp)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		switch resp.Data.Status {
+		case "complete":
+			if resp.Data.AccountID == 0 {
+				return resource.NonRetryableError(errors.New("the account request completed without an account ID"))
+			}
+			accountID = resp.Data.AccountID
+			return nil
+		case "failed":
+			return resource.NonRetryableError(fmt.Errorf("the account request failed: %s", resp.Data.Message))
+		}
+
+		log.Printf("[DEBUG] Waiting for AWS account request %d, status: %s", requestID, resp.Data.Status)
+		return resource.RetryableError(fmt.Errorf("the account request is %s", resp.Data.Status))
+	})
+
+	return accountID, err
+}
+
+func resourceAwsAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.AwsAccountResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/account/%s?account-type=aws", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AWS Account %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["account_alias"] = item.AccountAlias
+	data["account_number"] = item.AccountNumber
+	data["account_type_id"] = item.AccountTypeID
+	data["created_at"] = item.CreatedAt
+	data["email"] = item.Email
+	data["include_linked_account_spend"] = item.IncludeLinkedAccountSpend
+	data["labels"] = inflateLabels(item.Labels)
+	data["linked_role"] = item.LinkedRole
+	data["name"] = item.Name
+	data["payer_id"] = item.PayerID
+	data["project_id"] = item.ProjectID
+	data["skip_access_checking"] = item.SkipAccessChecking
+	data["start_datecode"] = item.StartDatecode
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set AWS Account",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceAwsAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `account_number` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("account_alias",
+		"include_linked_account_spend",
+		"linked_role",
+		"name",
+		"payer_id",
+		"skip_access_checking") {
+		hasChanged++
+		req := hc.AwsAccountUpdate{
+			AccountAlias:              d.Get("account_alias").(string),
+			IncludeLinkedAccountSpend: d.Get("include_linked_account_spend").(bool),
+			LinkedRole:                d.Get("linked_role").(string),
+			Name:                      d.Get("name").(string),
+			PayerID:                   d.Get("payer_id").(int),
+			SkipAccessChecking:        d.Get("skip_access_checking").(bool),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/account/%s?account-type=aws", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update AWS Account",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the account moved to another project.
+	if d.HasChange("project_id") {
+		hasChanged++
+		req := hc.AccountMove{
+			Financials:   d.Get("move_financials").(string),
+			MoveDatecode: hc.FlattenStringPointer(d, "move_datecode"),
+			ProjectID:    d.Get("project_id").(int),
+		}
+
+		_, err := c.POST(ctx, fmt.Sprintf("/v3/account/%s/move?account-type=aws", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to move AWS Account",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the labels have changed.
+	diags, hasChanged = LabelChanges(ctx, c, d, diags, hasChanged, fmt.Sprintf("/v3/account/%s", ID), "AWS Account")
+	if len(diags) > 0 {
+		return diags
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceAwsAccountRead(ctx, d, m)
+}
+
+func resourceAwsAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	// This removes the account from cloudtamer.io, it does not close the
+	// account in AWS.
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/account/%s?account-type=aws", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete AWS Account",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
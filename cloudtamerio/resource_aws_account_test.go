@@ -0,0 +1,208 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	resourceTypeAwsAccount        = "cloudtamerio_aws_account"
+	resourceNameAwsAccount        = "account1"
+	dataSourceLocalNameAwsAccount = "accounts"
+)
+
+// The AWS account tests need an existing account that is not registered in
+// cloudtamer.io yet, so they are configured with these environment variables:
+//
+//	CLOUDTAMERIO_TEST_AWS_ACCOUNT_NUMBER - the 12 digit account number.
+//	CLOUDTAMERIO_TEST_PAYER_ID           - the ID of the billing source of the account.
+//	CLOUDTAMERIO_TEST_PROJECT_ID         - the ID of the project to register the account in.
+//	CLOUDTAMERIO_TEST_MOVE_PROJECT_ID    - (optional) the ID of a project to move the account to.
+func testAccAwsAccountPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+
+	for _, name := range []string{
+		"CLOUDTAMERIO_TEST_AWS_ACCOUNT_NUMBER",
+		"CLOUDTAMERIO_TEST_PAYER_ID",
+		"CLOUDTAMERIO_TEST_PROJECT_ID",
+	} {
+		if os.Getenv(name) == "" {
+			t.Skipf("%s must be set for the AWS account acceptance tests", name)
+		}
+	}
+}
+
+// testAccEnvInt returns the integer value of an environment variable or 0 if
+// it is not set.
+func testAccEnvInt(name string) int {
+	v, _ := strconv.Atoi(os.Getenv(name))
+	return v
+}
+
+func testAccAwsAccount() hc.AwsAccountImport {
+	alias := "terraform-acctest"
+	return hc.AwsAccountImport{
+		AccountAlias:  &alias,
+		AccountNumber: os.Getenv("CLOUDTAMERIO_TEST_AWS_ACCOUNT_NUMBER"),
+		Name:          "Terraform AccTest Account",
+		PayerID:       testAccEnvInt("CLOUDTAMERIO_TEST_PAYER_ID"),
+		ProjectID:     testAccEnvInt("CLOUDTAMERIO_TEST_PROJECT_ID"),
+	}
+}
+
+func TestAccResourceAwsAccount(t *testing.T) {
+	account := testAccAwsAccount()
+
+	// Create
+	create := resource.TestStep{
+		Config: testAccAwsAccountGenerateResourceDeclaration(&account),
+		Check:  resource.ComposeTestCheckFunc(testAccAwsAccountCheckResource(&account)...),
+	}
+
+	// Update
+	alias := "terraform-acctest-updated"
+	account.Name = "(Updated) Terraform AccTest Account"
+	account.AccountAlias = &alias
+	update := resource.TestStep{
+		Config: testAccAwsAccountGenerateResourceDeclaration(&account),
+		Check:  resource.ComposeTestCheckFunc(testAccAwsAccountCheckResource(&account)...),
+	}
+
+	// Import
+	importState := resource.TestStep{
+		ResourceName:            resourceTypeAwsAccount + "." + resourceNameAwsAccount,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"last_updated", "move_financials"},
+	}
+
+	steps := []resource.TestStep{create, update, importState}
+
+	// Move to another project
+	if moveProjectID := testAccEnvInt("CLOUDTAMERIO_TEST_MOVE_PROJECT_ID"); moveProjectID != 0 {
+		account.ProjectID = moveProjectID
+		steps = append(steps, resource.TestStep{
+			Config: testAccAwsAccountGenerateResourceDeclaration(&account),
+			Check:  resource.ComposeTestCheckFunc(testAccAwsAccountCheckResource(&account)...),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccAwsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAwsAccountCheckResourceDestroy,
+		Steps:        steps,
+	})
+}
+
+func TestAccDataSourceAwsAccount(t *testing.T) {
+	account := testAccAwsAccount()
+	resourceDeclaration := testAccAwsAccountGenerateResourceDeclaration(&account)
+
+	// Filter on the account number so the data source depends on the
+	// resource being created first.
+	dataSourceDeclaration := fmt.Sprintf(`
+		data "%v" "%v" {
+			filter {
+				name   = "account_number"
+				values = [%v.%v.account_number]
+			}
+		}`, resourceTypeAwsAccount, dataSourceLocalNameAwsAccount, resourceTypeAwsAccount, resourceNameAwsAccount)
+
+	dataSourceName := fmt.Sprintf("data.%v.%v", resourceTypeAwsAccount, dataSourceLocalNameAwsAccount)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccAwsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAwsAccountCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDeclaration + "\n" + dataSourceDeclaration,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "list.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "list.0.name", account.Name),
+					resource.TestCheckResourceAttr(dataSourceName, "list.0.project_id", fmt.Sprint(account.ProjectID)),
+				),
+			},
+		},
+	})
+}
+
+// testAccAwsAccountCheckResource returns a slice of functions that validate the test resource's fields
+func testAccAwsAccountCheckResource(account *hc.AwsAccountImport) []resource.TestCheckFunc {
+	name := resourceTypeAwsAccount + "." + resourceNameAwsAccount
+
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(name, "account_alias", *account.AccountAlias),
+		resource.TestCheckResourceAttr(name, "account_number", account.AccountNumber),
+		resource.TestCheckResourceAttr(name, "name", account.Name),
+		resource.TestCheckResourceAttr(name, "payer_id", fmt.Sprint(account.PayerID)),
+		resource.TestCheckResourceAttr(name, "project_id", fmt.Sprint(account.ProjectID)),
+	}
+}
+
+// testAccAwsAccountGenerateResourceDeclaration generates a resource declaration string (a la main.tf)
+func testAccAwsAccountGenerateResourceDeclaration(account *hc.AwsAccountImport) string {
+	if account == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+		resource "%v" "%v" {
+			account_number = "%v"
+			account_alias  = "%v"
+			name           = "%v"
+			payer_id       = %v
+			project_id     = %v
+		}`,
+		resourceTypeAwsAccount, resourceNameAwsAccount,
+		account.AccountNumber,
+		*account.AccountAlias,
+		account.Name,
+		account.PayerID,
+		account.ProjectID,
+	)
+}
+
+// testAccAwsAccountCheckResourceDestroy verifies the resource has been destroyed
+func testAccAwsAccountCheckResourceDestroy(s *terraform.State) error {
+	// retrieve the connection established in Provider configuration
+	meta := testAccProvider.Meta()
+	if meta == nil {
+		return nil
+	}
+
+	c := meta.(*hc.Client)
+
+	// loop through the resources in state, verifying each resource is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceTypeAwsAccount {
+			continue
+		}
+
+		resp := new(hc.AwsAccountResponse)
+		err := c.GET(context.Background(), fmt.Sprintf("/v3/account/%s?account-type=aws", rs.Primary.ID), resp)
+		if err == nil {
+			if fmt.Sprint(resp.Data.ID) == rs.Primary.ID {
+				return fmt.Errorf("AWS Account (%s) still exists.", rs.Primary.ID)
+			}
+
+			return nil
+		}
+
+		// If the error is equivalent to 404 not found, the resource is destroyed.
+		// Otherwise, return the error
+		if !hc.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsAmi() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsAmiCreate,
+		ReadContext:   resourceAwsAmiRead,
+		UpdateContext: resourceAwsAmiUpdate,
+		DeleteContext: resourceAwsAmiDelete,
+		Importer:      importWithRead("cloudtamerio_aws_ami", resourceAwsAmiRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"aws_ami_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"expiration_alert_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"expiration_notify"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"expiration_notify": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"expires_at"},
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner_user_groups": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"owner_users": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"shared_accounts": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"sync_deprecation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"sync_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"unavailable_in_aws": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsAmiCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.AwsAmiCreate{
+		AccountID:           d.Get("account_id").(int),
+		AwsAmiID:            d.Get("aws_ami_id").(string),
+		Description:         d.Get("description").(string),
+		ExpirationAlertDays: d.Get("expiration_alert_days").(int),
+		ExpirationNotify:    d.Get("expiration_notify").(bool),
+		ExpiresAt:           hc.FlattenStringPointer(d, "expires_at"),
+		Name:                d.Get("name").(string),
+		OwnerUserGroupIds:   hc.FlattenGenericIDPointer(d, "owner_user_groups"),
+		OwnerUserIds:        hc.FlattenGenericIDPointer(d, "owner_users"),
+		Region:              d.Get("region").(string),
+		SharedAccountIds:    hc.FlattenGenericIDPointer(d, "shared_accounts"),
+		SyncDeprecation:     d.Get("sync_deprecation").(bool),
+		SyncTags:            d.Get("sync_tags").(bool),
+	}
+
+	resp, err := c.POST(ctx, "/v3/ami", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create AWS AMI",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Name),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create AWS AMI",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Name),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceAwsAmiRead(ctx, d, m)
+}
+
+func resourceAwsAmiRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.AwsAmiResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/ami/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AWS AMI %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS AMI",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["account_id"] = item.Ami.AccountID
+	data["aws_ami_id"] = item.Ami.AwsAmiID
+	data["description"] = item.Ami.Description
+	data["expiration_alert_days"] = item.Ami.ExpirationAlertDays
+	data["expiration_notify"] = item.Ami.ExpirationNotify
+	data["expires_at"] = item.Ami.ExpiresAt
+	data["name"] = item.Ami.Name
+	if hc.InflateObjectWithID(item.OwnerUserGroups) != nil {
+		data["owner_user_groups"] = hc.InflateObjectWithID(item.OwnerUserGroups)
+	}
+	if hc.InflateObjectWithID(item.OwnerUsers) != nil {
+		data["owner_users"] = hc.InflateObjectWithID(item.OwnerUsers)
+	}
+	data["region"] = item.Ami.Region
+	if hc.InflateObjectWithID(item.SharedAccounts) != nil {
+		data["shared_accounts"] = hc.InflateObjectWithID(item.SharedAccounts)
+	}
+	data["sync_deprecation"] = item.Ami.SyncDeprecation
+	data["sync_tags"] = item.Ami.SyncTags
+	data["unavailable_in_aws"] = item.Ami.UnavailableInAws
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set AWS AMI",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceAwsAmiUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `aws_ami_id` in AWS and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("description",
+		"expiration_alert_days",
+		"expiration_notify",
+		"expires_at",
+		"name",
+		"sync_deprecation",
+		"sync_tags") {
+		hasChanged++
+		req := hc.AwsAmiUpdate{
+			Description:         d.Get("description").(string),
+			ExpirationAlertDays: d.Get("expiration_alert_days").(int),
+			ExpirationNotify:    d.Get("expiration_notify").(bool),
+			ExpiresAt:           hc.FlattenStringPointer(d, "expires_at"),
+			Name:                d.Get("name").(string),
+			SyncDeprecation:     d.Get("sync_deprecation").(bool),
+			SyncTags:            d.Get("sync_tags").(bool),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/ami/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update AWS AMI",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the owners have changed.
+	if d.HasChanges("owner_user_groups",
+		"owner_users") {
+		hasChanged++
+		arrAddOwnerUserGroupIds, arrRemoveOwnerUserGroupIds, _, _ := hc.AssociationChanged(d, "owner_user_groups")
+		arrAddOwnerUserIds, arrRemoveOwnerUserIds, _, _ := hc.AssociationChanged(d, "owner_users")
+
+		if len(arrAddOwnerUserGroupIds) > 0 ||
+			len(arrAddOwnerUserIds) > 0 {
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/ami/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
+				OwnerUserIds:      &arrAddOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to add owners on AWS AMI",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+
+		if len(arrRemoveOwnerUserGroupIds) > 0 ||
+			len(arrRemoveOwnerUserIds) > 0 {
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/ami/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
+				OwnerUserIds:      &arrRemoveOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to remove owners on AWS AMI",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+	}
+
+	// Determine if the accounts the AMI is shared with have changed.
+	if d.HasChange("shared_accounts") {
+		hasChanged++
+		arrAddAccountIds, arrRemoveAccountIds, _, _ := hc.AssociationChanged(d, "shared_accounts")
+
+		if len(arrAddAccountIds) > 0 {
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/ami/%s/share", ID), hc.AwsAmiShare{
+				AccountIds: &arrAddAccountIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to share AWS AMI",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+
+		if len(arrRemoveAccountIds) > 0 {
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/ami/%s/share", ID), hc.AwsAmiShare{
+				AccountIds: &arrRemoveAccountIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to unshare AWS AMI",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceAwsAmiRead(ctx, d, m)
+}
+
+func resourceAwsAmiDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/ami/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete AWS AMI",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
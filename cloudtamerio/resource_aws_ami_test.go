@@ -0,0 +1,78 @@
+package cloudtamerio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAwsAmiExpiresAt(t *testing.T) {
+	validate := resourceAwsAmi().Schema["expires_at"].ValidateFunc
+
+	_, errs := validate("2030-01-01T00:00:00Z", "expires_at")
+	assert.Empty(t, errs)
+
+	_, errs = validate("2030-01-01", "expires_at")
+	assert.NotEmpty(t, errs)
+
+	// The same time in another offset or precision is not a change.
+	suppress := resourceAwsAmi().Schema["expires_at"].DiffSuppressFunc
+	assert.True(t, suppress("expires_at", "2030-01-01T00:00:00Z", "2030-01-01T01:00:00.000+01:00", nil))
+	assert.False(t, suppress("expires_at", "2030-01-01T00:00:00Z", "2030-01-02T00:00:00Z", nil))
+}
+
+func TestResourceAwsAmiSchema(t *testing.T) {
+	assert.NoError(t, resourceAwsAmi().InternalValidate(nil, true))
+	assert.NoError(t, resourceAwsServiceCatalogPortfolio().InternalValidate(nil, true))
+}
+
+func TestResourceAwsAmiUpdateSharedAccounts(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Write([]byte(`{"status":200,"data":{"ami":{"id":4,"account_id":1,"aws_ami_id":"ami-0123456789abcdef0","name":"Golden","region":"us-east-1"},"shared_accounts":[{"id":2},{"id":3}]}}`))
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"status":200,"record_id":0}`))
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	// The AMI is shared with accounts 1 and 2 and the configuration has
+	// accounts 2 and 3.
+	state := &terraform.InstanceState{
+		ID: "4",
+		Attributes: map[string]string{
+			"shared_accounts.#":    "2",
+			"shared_accounts.0.id": "1",
+			"shared_accounts.1.id": "2",
+		},
+	}
+	d, err := schema.InternalMap(resourceAwsAmi().Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"shared_accounts.0.id": {Old: "1", New: "2"},
+			"shared_accounts.1.id": {Old: "2", New: "3"},
+		},
+	})
+	assert.NoError(t, err)
+
+	diags := resourceAwsAmiUpdate(context.Background(), d, c)
+	assert.Empty(t, diags)
+	assert.Equal(t, []string{
+		`POST /api/v3/ami/4/share {"account_ids":[3]}`,
+		`DELETE /api/v3/ami/4/share {"account_ids":[1]}`,
+	}, requests)
+	assert.Equal(t, 2, d.Get("shared_accounts.#"))
+	assert.Equal(t, 3, d.Get("shared_accounts.1.id"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceAwsCloudformationTemplate() *schema.Resource {
 		ReadContext:   resourceAwsCloudformationTemplateRead,
 		UpdateContext: resourceAwsCloudformationTemplateUpdate,
 		DeleteContext: resourceAwsCloudformationTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceAwsCloudformationTemplateRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_aws_cloudformation_template", resourceAwsCloudformationTemplateRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -109,7 +111,7 @@ func resourceAwsCloudformationTemplateCreate(ctx context.Context, d *schema.Reso
 		TerminationProtection: d.Get("termination_protection").(bool),
 	}
 
-	resp, err := c.POST("/v3/cft", post)
+	resp, err := c.POST(ctx, "/v3/cft", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -139,8 +141,13 @@ func resourceAwsCloudformationTemplateRead(ctx context.Context, d *schema.Resour
 	ID := d.Id()
 
 	resp := new(hc.CFTResponseWithOwners)
-	err := c.GET(fmt.Sprintf("/v3/cft/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/cft/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AwsCloudformationTemplate %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AwsCloudformationTemplate",
@@ -211,7 +218,7 @@ func resourceAwsCloudformationTemplateUpdate(ctx context.Context, d *schema.Reso
 			TerminationProtection: d.Get("termination_protection").(bool),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/cft/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/cft/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -231,7 +238,7 @@ func resourceAwsCloudformationTemplateUpdate(ctx context.Context, d *schema.Reso
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -247,7 +254,7 @@ func resourceAwsCloudformationTemplateUpdate(ctx context.Context, d *schema.Reso
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/cft/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -274,7 +281,7 @@ func resourceAwsCloudformationTemplateDelete(ctx context.Context, d *schema.Reso
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/cft/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/cft/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceAwsIamPolicy() *schema.Resource {
 		ReadContext:   resourceAwsIamPolicyRead,
 		UpdateContext: resourceAwsIamPolicyUpdate,
 		DeleteContext: resourceAwsIamPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceAwsIamPolicyRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_aws_iam_policy", resourceAwsIamPolicyRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -101,7 +103,7 @@ func resourceAwsIamPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 		Policy:            d.Get("policy").(string),
 	}
 
-	resp, err := c.POST("/v3/iam-policy", post)
+	resp, err := c.POST(ctx, "/v3/iam-policy", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -131,8 +133,13 @@ func resourceAwsIamPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 	ID := d.Id()
 
 	resp := new(hc.IAMPolicyResponse)
-	err := c.GET(fmt.Sprintf("/v3/iam-policy/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/iam-policy/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AwsIamPolicy %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AwsIamPolicy",
@@ -192,7 +199,7 @@ func resourceAwsIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 			Policy:      d.Get("policy").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/iam-policy/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/iam-policy/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -212,7 +219,7 @@ func resourceAwsIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -228,7 +235,7 @@ func resourceAwsIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/iam-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -255,7 +262,7 @@ func resourceAwsIamPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/iam-policy/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/iam-policy/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,276 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsServiceCatalogPortfolio() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsServiceCatalogPortfolioCreate,
+		ReadContext:   resourceAwsServiceCatalogPortfolioRead,
+		UpdateContext: resourceAwsServiceCatalogPortfolioUpdate,
+		DeleteContext: resourceAwsServiceCatalogPortfolioDelete,
+		Importer:      importWithRead("cloudtamerio_aws_service_catalog_portfolio", resourceAwsServiceCatalogPortfolioRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"aws_portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner_user_groups": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"owner_users": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"share_tag_options": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.AwsServiceCatalogPortfolioCreate{
+		AccountID:         d.Get("account_id").(int),
+		AwsPortfolioID:    d.Get("aws_portfolio_id").(string),
+		Description:       d.Get("description").(string),
+		Name:              d.Get("name").(string),
+		OwnerUserGroupIds: hc.FlattenGenericIDPointer(d, "owner_user_groups"),
+		OwnerUserIds:      hc.FlattenGenericIDPointer(d, "owner_users"),
+		Region:            d.Get("region").(string),
+		ShareTagOptions:   d.Get("share_tag_options").(bool),
+	}
+
+	resp, err := c.POST(ctx, "/v3/service-catalog-portfolio", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create AWS Service Catalog portfolio",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Name),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create AWS Service Catalog portfolio",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Name),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceAwsServiceCatalogPortfolioRead(ctx, d, m)
+}
+
+func resourceAwsServiceCatalogPortfolioRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.AwsServiceCatalogPortfolioResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/service-catalog-portfolio/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AWS Service Catalog portfolio %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read AWS Service Catalog portfolio",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["account_id"] = item.Portfolio.AccountID
+	data["aws_portfolio_id"] = item.Portfolio.AwsPortfolioID
+	data["description"] = item.Portfolio.Description
+	data["name"] = item.Portfolio.Name
+	if hc.InflateObjectWithID(item.OwnerUserGroups) != nil {
+		data["owner_user_groups"] = hc.InflateObjectWithID(item.OwnerUserGroups)
+	}
+	if hc.InflateObjectWithID(item.OwnerUsers) != nil {
+		data["owner_users"] = hc.InflateObjectWithID(item.OwnerUsers)
+	}
+	data["region"] = item.Portfolio.Region
+	data["share_tag_options"] = item.Portfolio.ShareTagOptions
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set AWS Service Catalog portfolio",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceAwsServiceCatalogPortfolioUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `aws_portfolio_id` in AWS and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("description",
+		"name",
+		"share_tag_options") {
+		hasChanged++
+		req := hc.AwsServiceCatalogPortfolioUpdate{
+			Description:     d.Get("description").(string),
+			Name:            d.Get("name").(string),
+			ShareTagOptions: d.Get("share_tag_options").(bool),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/service-catalog-portfolio/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update AWS Service Catalog portfolio",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the owners have changed.
+	if d.HasChanges("owner_user_groups",
+		"owner_users") {
+		hasChanged++
+		arrAddOwnerUserGroupIds, arrRemoveOwnerUserGroupIds, _, _ := hc.AssociationChanged(d, "owner_user_groups")
+		arrAddOwnerUserIds, arrRemoveOwnerUserIds, _, _ := hc.AssociationChanged(d, "owner_users")
+
+		if len(arrAddOwnerUserGroupIds) > 0 ||
+			len(arrAddOwnerUserIds) > 0 {
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/service-catalog-portfolio/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
+				OwnerUserIds:      &arrAddOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to add owners on AWS Service Catalog portfolio",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+
+		if len(arrRemoveOwnerUserGroupIds) > 0 ||
+			len(arrRemoveOwnerUserIds) > 0 {
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/service-catalog-portfolio/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
+				OwnerUserIds:      &arrRemoveOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to remove owners on AWS Service Catalog portfolio",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceAwsServiceCatalogPortfolioRead(ctx, d, m)
+}
+
+func resourceAwsServiceCatalogPortfolioDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/service-catalog-portfolio/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete AWS Service Catalog portfolio",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceAzureArmTemplate() *schema.Resource {
 		ReadContext:   resourceAzureArmTemplateRead,
 		UpdateContext: resourceAzureArmTemplateUpdate,
 		DeleteContext: resourceAzureArmTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceAzureArmTemplateRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_azure_arm_template", resourceAzureArmTemplateRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -114,7 +116,7 @@ func resourceAzureArmTemplateCreate(ctx context.Context, d *schema.ResourceData,
 		TemplateParameters:    d.Get("template_parameters").(string),
 	}
 
-	resp, err := c.POST("/v3/azure-arm-template", post)
+	resp, err := c.POST(ctx, "/v3/azure-arm-template", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -144,8 +146,13 @@ func resourceAzureArmTemplateRead(ctx context.Context, d *schema.ResourceData, m
 	ID := d.Id()
 
 	resp := new(hc.AzureARMTemplateResponse)
-	err := c.GET(fmt.Sprintf("/v3/azure-arm-template/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/azure-arm-template/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Azure ARM Template %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Azure ARM Template",
@@ -211,7 +218,7 @@ func resourceAzureArmTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 			TemplateParameters: d.Get("template_parameters").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/azure-arm-template/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/azure-arm-template/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -231,7 +238,7 @@ func resourceAzureArmTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -247,7 +254,7 @@ func resourceAzureArmTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/azure-arm-template/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -274,7 +281,7 @@ func resourceAzureArmTemplateDelete(ctx context.Context, d *schema.ResourceData,
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/azure-arm-template/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/azure-arm-template/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceAzurePolicy() *schema.Resource {
 		ReadContext:   resourceAzurePolicyRead,
 		UpdateContext: resourceAzurePolicyUpdate,
 		DeleteContext: resourceAzurePolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceAzurePolicyRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_azure_policy", resourceAzurePolicyRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -92,7 +94,7 @@ func resourceAzurePolicyCreate(ctx context.Context, d *schema.ResourceData, m in
 		OwnerUsers:      hc.FlattenGenericIDPointer(d, "owner_users"),
 	}
 
-	resp, err := c.POST("/v3/azure-policy", post)
+	resp, err := c.POST(ctx, "/v3/azure-policy", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -122,8 +124,13 @@ func resourceAzurePolicyRead(ctx context.Context, d *schema.ResourceData, m inte
 	ID := d.Id()
 
 	resp := new(hc.AzurePolicyResponse)
-	err := c.GET(fmt.Sprintf("/v3/azure-policy/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/azure-policy/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AzurePolicy %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AzurePolicy",
@@ -184,7 +191,7 @@ func resourceAzurePolicyUpdate(ctx context.Context, d *schema.ResourceData, m in
 			Policy:      d.Get("policy").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/azure-policy/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/azure-policy/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -204,7 +211,7 @@ func resourceAzurePolicyUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -220,7 +227,7 @@ func resourceAzurePolicyUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/azure-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -247,7 +254,7 @@ func resourceAzurePolicyDelete(ctx context.Context, d *schema.ResourceData, m in
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/azure-policy/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/azure-policy/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
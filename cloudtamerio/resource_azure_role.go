@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"reflect"
 	"strconv"
 	"time"
@@ -20,11 +21,12 @@ func resourceAzureRole() *schema.Resource {
 		ReadContext:   resourceAzureRoleRead,
 		UpdateContext: resourceAzureRoleUpdate,
 		DeleteContext: resourceAzureRoleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceAzureRoleRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_azure_role", resourceAzureRoleRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -99,7 +101,7 @@ func resourceAzureRoleCreate(ctx context.Context, d *schema.ResourceData, m inte
 		RolePermissions:   d.Get("role_permissions").(string),
 	}
 
-	resp, err := c.POST("/v3/azure-role", post)
+	resp, err := c.POST(ctx, "/v3/azure-role", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -129,8 +131,13 @@ func resourceAzureRoleRead(ctx context.Context, d *schema.ResourceData, m interf
 	ID := d.Id()
 
 	resp := new(hc.AzureRoleResponse)
-	err := c.GET(fmt.Sprintf("/v3/azure-role/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/azure-role/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] AzureRole %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read AzureRole",
@@ -188,7 +195,7 @@ func resourceAzureRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			RolePermissions: d.Get("role_permissions").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/azure-role/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/azure-role/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -208,7 +215,7 @@ func resourceAzureRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -224,7 +231,7 @@ func resourceAzureRoleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/azure-role/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -251,7 +258,7 @@ func resourceAzureRoleDelete(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/azure-role/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/azure-role/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,281 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBudgetEnforcement() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBudgetEnforcementCreate,
+		ReadContext:   resourceBudgetEnforcementRead,
+		UpdateContext: resourceBudgetEnforcementUpdate,
+		DeleteContext: resourceBudgetEnforcementDelete,
+		Importer:      importWithRead("cloudtamerio_budget_enforcement", resourceBudgetEnforcementRead),
+		CustomizeDiff: validateBudgetEnforcementWebhook,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"notify", "freeze", "webhook"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notify_user_groups": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"notify_users": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"ou_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true, // Not allowed to be changed, forces new item if changed.
+				ExactlyOneOf: []string{"ou_id", "project_id"},
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"threshold_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "percent",
+				ValidateFunc: validation.StringInSlice([]string{"percent", "amount"}, false),
+			},
+			"timeframe": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "month",
+				ValidateFunc: validation.StringInSlice([]string{"month", "lifetime"}, false),
+			},
+			"webhook_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceBudgetEnforcementCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.BudgetEnforcementCreate{
+		Action:             d.Get("action").(string),
+		Description:        d.Get("description").(string),
+		NotifyUserGroupIds: hc.FlattenGenericIDPointer(d, "notify_user_groups"),
+		NotifyUserIds:      hc.FlattenGenericIDPointer(d, "notify_users"),
+		OUID:               hc.FlattenIntPointer(d, "ou_id"),
+		ProjectID:          hc.FlattenIntPointer(d, "project_id"),
+		Threshold:          d.Get("threshold").(float64),
+		ThresholdType:      d.Get("threshold_type").(string),
+		Timeframe:          d.Get("timeframe").(string),
+		WebhookID:          hc.FlattenIntPointer(d, "webhook_id"),
+	}
+
+	resp, err := c.POST(ctx, "/v3/budget-enforcement", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Budget Enforcement",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Budget Enforcement",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceBudgetEnforcementRead(ctx, d, m)
+}
+
+func resourceBudgetEnforcementRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.BudgetEnforcementResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/budget-enforcement/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Budget Enforcement %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Budget Enforcement",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["action"] = item.BudgetEnforcement.Action
+	data["description"] = item.BudgetEnforcement.Description
+	if hc.InflateObjectWithID(item.NotifyUserGroups) != nil {
+		data["notify_user_groups"] = hc.InflateObjectWithID(item.NotifyUserGroups)
+	}
+	if hc.InflateObjectWithID(item.NotifyUsers) != nil {
+		data["notify_users"] = hc.InflateObjectWithID(item.NotifyUsers)
+	}
+	data["ou_id"] = item.BudgetEnforcement.OUID
+	data["project_id"] = item.BudgetEnforcement.ProjectID
+	data["threshold"] = item.BudgetEnforcement.Threshold
+	data["threshold_type"] = item.BudgetEnforcement.ThresholdType
+	data["timeframe"] = item.BudgetEnforcement.Timeframe
+	data["webhook_id"] = item.BudgetEnforcement.WebhookID
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Budget Enforcement",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceBudgetEnforcementUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `ou_id` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("action",
+		"description",
+		"notify_user_groups",
+		"notify_users",
+		"threshold",
+		"threshold_type",
+		"timeframe",
+		"webhook_id") {
+		hasChanged++
+
+		req := hc.BudgetEnforcementUpdate{
+			Action:             d.Get("action").(string),
+			Description:        d.Get("description").(string),
+			NotifyUserGroupIds: hc.FlattenGenericIDPointer(d, "notify_user_groups"),
+			NotifyUserIds:      hc.FlattenGenericIDPointer(d, "notify_users"),
+			Threshold:          d.Get("threshold").(float64),
+			ThresholdType:      d.Get("threshold_type").(string),
+			Timeframe:          d.Get("timeframe").(string),
+			WebhookID:          hc.FlattenIntPointer(d, "webhook_id"),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/budget-enforcement/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Budget Enforcement",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceBudgetEnforcementRead(ctx, d, m)
+}
+
+func resourceBudgetEnforcementDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/budget-enforcement/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Budget Enforcement",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// validateBudgetEnforcementWebhook checks a webhook is set when the action
+// runs a webhook so a bad configuration fails during the plan instead of the
+// apply. A webhook ID that is not known until the apply is allowed.
+func validateBudgetEnforcementWebhook(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("action").(string) != "webhook" || !d.NewValueKnown("webhook_id") {
+		return nil
+	}
+
+	if _, ok := d.GetOk("webhook_id"); !ok {
+		return errors.New("webhook_id is required when the action is webhook")
+	}
+
+	return nil
+}
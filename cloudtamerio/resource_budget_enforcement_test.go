@@ -0,0 +1,49 @@
+package cloudtamerio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBudgetEnforcementWebhook(t *testing.T) {
+	diff := func(raw map[string]interface{}) error {
+		_, err := resourceBudgetEnforcement().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+		return err
+	}
+
+	// Notify does not need a webhook
+	assert.NoError(t, diff(map[string]interface{}{
+		"action":    "notify",
+		"ou_id":     1,
+		"threshold": 80.0,
+	}))
+
+	// Webhook with a webhook ID
+	assert.NoError(t, diff(map[string]interface{}{
+		"action":     "webhook",
+		"ou_id":      1,
+		"threshold":  80.0,
+		"webhook_id": 3,
+	}))
+
+	// Webhook with a webhook ID that is not known until the apply
+	assert.NoError(t, diff(map[string]interface{}{
+		"action":     "webhook",
+		"ou_id":      1,
+		"threshold":  80.0,
+		"webhook_id": "74D93920-ED26-11E3-AC10-0800200C9A66",
+	}))
+
+	// Webhook without a webhook ID fails during the plan
+	err := diff(map[string]interface{}{
+		"action":    "webhook",
+		"ou_id":     1,
+		"threshold": 80.0,
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "webhook_id is required")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceCloudRule() *schema.Resource {
 		ReadContext:   resourceCloudRuleRead,
 		UpdateContext: resourceCloudRuleUpdate,
 		DeleteContext: resourceCloudRuleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceCloudRuleRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_cloud_rule", resourceCloudRuleRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -248,7 +250,7 @@ func resourceCloudRuleCreate(ctx context.Context, d *schema.ResourceData, m inte
 		ServiceControlPolicyIds:       hc.FlattenGenericIDPointer(d, "service_control_policies"),
 	}
 
-	resp, err := c.POST("/v3/cloud-rule", post)
+	resp, err := c.POST(ctx, "/v3/cloud-rule", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -278,8 +280,13 @@ func resourceCloudRuleRead(ctx context.Context, d *schema.ResourceData, m interf
 	ID := d.Id()
 
 	resp := new(hc.CloudRuleResponse)
-	err := c.GET(fmt.Sprintf("/v3/cloud-rule/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/cloud-rule/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] CloudRule %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read CloudRule",
@@ -379,7 +386,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			PreWebhookID:  hc.FlattenIntPointer(d, "pre_webhook_id"),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/cloud-rule/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/cloud-rule/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -429,7 +436,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			len(arrAddOUIds) > 0 ||
 			len(arrAddProjectIds) > 0 ||
 			len(arrAddServiceControlPolicyIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsAdd{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsAdd{
 				AzureArmTemplateDefinitionIds: &arrAddAzureArmTemplateDefinitionIds,
 				AzurePolicyDefinitionIds:      &arrAddAzurePolicyDefinitionIds,
 				AzureRoleDefinitionIds:        &arrAddAzureRoleDefinitionIds,
@@ -465,7 +472,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			len(arrRemoveOUIds) > 0 ||
 			len(arrRemoveProjectIds) > 0 ||
 			len(arrRemoveServiceControlPolicyIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsRemove{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/cloud-rule/%s/association", ID), hc.CloudRuleAssociationsRemove{
 				AzureArmTemplateDefinitionIds: &arrRemoveAzureArmTemplateDefinitionIds,
 				AzurePolicyDefinitionIds:      &arrRemoveAzurePolicyDefinitionIds,
 				AzureRoleDefinitionIds:        &arrRemoveAzureRoleDefinitionIds,
@@ -499,7 +506,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -515,7 +522,7 @@ func resourceCloudRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/cloud-rule/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -542,7 +549,7 @@ func resourceCloudRuleDelete(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/cloud-rule/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/cloud-rule/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
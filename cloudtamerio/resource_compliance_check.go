@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceComplianceCheck() *schema.Resource {
 		ReadContext:   resourceComplianceCheckRead,
 		UpdateContext: resourceComplianceCheckUpdate,
 		DeleteContext: resourceComplianceCheckDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceComplianceCheckRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_compliance_check", resourceComplianceCheckRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -150,7 +152,7 @@ func resourceComplianceCheckCreate(ctx context.Context, d *schema.ResourceData,
 		SeverityTypeID:        hc.FlattenIntPointer(d, "severity_type_id"),
 	}
 
-	resp, err := c.POST("/v3/compliance/check", post)
+	resp, err := c.POST(ctx, "/v3/compliance/check", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -180,8 +182,13 @@ func resourceComplianceCheckRead(ctx context.Context, d *schema.ResourceData, m
 	ID := d.Id()
 
 	resp := new(hc.ComplianceCheckWithOwnersResponse)
-	err := c.GET(fmt.Sprintf("/v3/compliance/check/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/compliance/check/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] ComplianceCheck %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read ComplianceCheck",
@@ -272,7 +279,7 @@ func resourceComplianceCheckUpdate(ctx context.Context, d *schema.ResourceData,
 			SeverityTypeID:        hc.FlattenIntPointer(d, "severity_type_id"),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/compliance/check/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/compliance/check/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -292,7 +299,7 @@ func resourceComplianceCheckUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -308,7 +315,7 @@ func resourceComplianceCheckUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/check/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -335,7 +342,7 @@ func resourceComplianceCheckDelete(ctx context.Context, d *schema.ResourceData,
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/compliance/check/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/check/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,256 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceComplianceExemption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComplianceExemptionCreate,
+		ReadContext:   resourceComplianceExemptionRead,
+		UpdateContext: resourceComplianceExemptionUpdate,
+		DeleteContext: resourceComplianceExemptionDelete,
+		Importer:      importWithRead("cloudtamerio_compliance_exemption", resourceComplianceExemptionRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true, // Not allowed to be changed, forces new item if changed.
+				ExactlyOneOf: []string{"account_id", "project_id"},
+			},
+			"compliance_check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by_user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateComplianceExemptionExpiry,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"justification": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_arn_pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+		},
+	}
+}
+
+func resourceComplianceExemptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.ComplianceExemptionCreate{
+		AccountID:          hc.FlattenIntPointer(d, "account_id"),
+		ComplianceCheckID:  d.Get("compliance_check_id").(int),
+		ExpiresAt:          hc.FlattenStringPointer(d, "expires_at"),
+		Justification:      d.Get("justification").(string),
+		ProjectID:          hc.FlattenIntPointer(d, "project_id"),
+		Region:             d.Get("region").(string),
+		ResourceArnPattern: d.Get("resource_arn_pattern").(string),
+	}
+
+	resp, err := c.POST(ctx, "/v3/compliance/exemption", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Compliance Exemption",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.ComplianceCheckID),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Compliance Exemption",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.ComplianceCheckID),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceComplianceExemptionRead(ctx, d, m)
+}
+
+func resourceComplianceExemptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.ComplianceExemptionResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/compliance/exemption/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Compliance Exemption %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Compliance Exemption",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["account_id"] = item.AccountID
+	data["compliance_check_id"] = item.ComplianceCheckID
+	data["created_at"] = item.CreatedAt
+	data["created_by_user_id"] = item.CreatedByUserID
+	data["expires_at"] = item.ExpiresAt
+	data["justification"] = item.Justification
+	data["project_id"] = item.ProjectID
+	data["region"] = item.Region
+	data["resource_arn_pattern"] = item.ResourceArnPattern
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Compliance Exemption",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceComplianceExemptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `compliance_check_id` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("expires_at",
+		"justification",
+		"region",
+		"resource_arn_pattern") {
+		hasChanged++
+		req := hc.ComplianceExemptionUpdate{
+			ExpiresAt:          hc.FlattenStringPointer(d, "expires_at"),
+			Justification:      d.Get("justification").(string),
+			Region:             d.Get("region").(string),
+			ResourceArnPattern: d.Get("resource_arn_pattern").(string),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/compliance/exemption/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Compliance Exemption",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceComplianceExemptionRead(ctx, d, m)
+}
+
+func resourceComplianceExemptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/exemption/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Compliance Exemption",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// validateComplianceExemptionExpiry requires an RFC 3339 time and warns during
+// the plan when the exemption has already expired so it can be renewed or
+// removed.
+func validateComplianceExemptionExpiry(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expiresAt, err := time.Parse(time.RFC3339, v.(string))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid expires_at",
+			Detail:        fmt.Sprintf("Error: %v\nItem: %v", "the expiry must be an RFC 3339 time like 2030-01-01T00:00:00Z", v),
+			AttributePath: path,
+		})
+		return diags
+	}
+
+	if expiresAt.Before(time.Now()) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Compliance exemption has expired",
+			Detail:        fmt.Sprintf("The exemption expired at %v and its findings are reported again. Renew or remove the exemption.", v),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
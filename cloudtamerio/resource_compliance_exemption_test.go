@@ -0,0 +1,54 @@
+package cloudtamerio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateComplianceExemptionExpiry(t *testing.T) {
+	path := cty.GetAttrPath("expires_at")
+
+	// An exemption that has not expired is valid.
+	future := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	assert.Empty(t, validateComplianceExemptionExpiry(future, path))
+
+	// An expired exemption is a warning, not an error.
+	diags := validateComplianceExemptionExpiry("2020-01-01T00:00:00Z", path)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Compliance exemption has expired", diags[0].Summary)
+	}
+
+	// A date without a time is an error.
+	diags = validateComplianceExemptionExpiry("2030-01-01", path)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Error, diags[0].Severity)
+	}
+}
+
+func TestComplianceExemptionExpiryDiff(t *testing.T) {
+	// The expiry normalized by cloudtamer.io is not a change.
+	state := &terraform.InstanceState{
+		ID: "3",
+		Attributes: map[string]string{
+			"compliance_check_id": "2",
+			"expires_at":          "2030-01-01T05:00:00.000Z",
+			"justification":       "Approved by security.",
+		},
+	}
+	diff, err := resourceComplianceExemption().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"compliance_check_id": 2,
+		"expires_at":          "2030-01-01T00:00:00-05:00",
+		"justification":       "Approved by security.",
+	}), nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.Nil(t, diff.Attributes["expires_at"])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceComplianceStandard() *schema.Resource {
 		ReadContext:   resourceComplianceStandardRead,
 		UpdateContext: resourceComplianceStandardUpdate,
 		DeleteContext: resourceComplianceStandardDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceComplianceStandardRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_compliance_standard", resourceComplianceStandardRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -105,7 +107,7 @@ func resourceComplianceStandardCreate(ctx context.Context, d *schema.ResourceDat
 		OwnerUserIds:       hc.FlattenGenericIDPointer(d, "owner_users"),
 	}
 
-	resp, err := c.POST("/v3/compliance/standard", post)
+	resp, err := c.POST(ctx, "/v3/compliance/standard", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -135,8 +137,13 @@ func resourceComplianceStandardRead(ctx context.Context, d *schema.ResourceData,
 	ID := d.Id()
 
 	resp := new(hc.ComplianceStandardResponse)
-	err := c.GET(fmt.Sprintf("/v3/compliance/standard/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] ComplianceStandard %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read ComplianceStandard",
@@ -195,7 +202,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 			Name:        d.Get("name").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/compliance/standard/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -212,7 +219,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 		arrAddComplianceCheckIds, arrRemoveComplianceCheckIds, _, _ := hc.AssociationChanged(d, "compliance_checks")
 
 		if len(arrAddComplianceCheckIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsAdd{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsAdd{
 				ComplianceCheckIds: &arrAddComplianceCheckIds,
 			})
 			if err != nil {
@@ -226,7 +233,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		if len(arrRemoveComplianceCheckIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsRemove{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/standard/%s/association", ID), hc.ComplianceStandardAssociationsRemove{
 				ComplianceCheckIds: &arrRemoveComplianceCheckIds,
 			})
 			if err != nil {
@@ -249,7 +256,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -265,7 +272,7 @@ func resourceComplianceStandardUpdate(ctx context.Context, d *schema.ResourceDat
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/standard/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -292,7 +299,7 @@ func resourceComplianceStandardDelete(ctx context.Context, d *schema.ResourceDat
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/compliance/standard/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/standard/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,234 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceComplianceStandardAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComplianceStandardAttachmentCreate,
+		ReadContext:   resourceComplianceStandardAttachmentRead,
+		UpdateContext: resourceComplianceStandardAttachmentUpdate,
+		DeleteContext: resourceComplianceStandardAttachmentDelete,
+		Importer:      importWithRead("cloudtamerio_compliance_standard_attachment", resourceComplianceStandardAttachmentRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true, // Not allowed to be changed, forces new item if changed.
+				ExactlyOneOf: []string{"account_id", "ou_id", "project_id"},
+			},
+			"compliance_standard_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"enforcement_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "audit",
+				ValidateFunc: validation.StringInSlice([]string{"audit", "notify", "auto_remediate"}, false),
+			},
+			"exempt_accounts": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"exempt_compliance_checks": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"ou_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+		},
+	}
+}
+
+func resourceComplianceStandardAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.ComplianceStandardAttachmentCreate{
+		AccountID:                hc.FlattenIntPointer(d, "account_id"),
+		ComplianceStandardID:     d.Get("compliance_standard_id").(int),
+		EnforcementMode:          d.Get("enforcement_mode").(string),
+		ExemptAccountIds:         hc.FlattenGenericIDPointer(d, "exempt_accounts"),
+		ExemptComplianceCheckIds: hc.FlattenGenericIDPointer(d, "exempt_compliance_checks"),
+		OUID:                     hc.FlattenIntPointer(d, "ou_id"),
+		ProjectID:                hc.FlattenIntPointer(d, "project_id"),
+	}
+
+	resp, err := c.POST(ctx, "/v3/compliance/standard-attachment", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Compliance Standard Attachment",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.ComplianceStandardID),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Compliance Standard Attachment",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.ComplianceStandardID),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceComplianceStandardAttachmentRead(ctx, d, m)
+}
+
+func resourceComplianceStandardAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	// The attachment is read back as it is in cloudtamer.io so a standard
+	// detached in the application is recreated and exemptions added there
+	// show up in the plan.
+	resp := new(hc.ComplianceStandardAttachmentResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/compliance/standard-attachment/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Compliance Standard Attachment %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Compliance Standard Attachment",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["account_id"] = item.AccountID
+	data["compliance_standard_id"] = item.ComplianceStandardID
+	data["enforcement_mode"] = item.EnforcementMode
+	data["exempt_accounts"] = hc.InflateObjectWithID(item.ExemptAccounts)
+	data["exempt_compliance_checks"] = hc.InflateObjectWithID(item.ExemptComplianceChecks)
+	data["ou_id"] = item.OUID
+	data["project_id"] = item.ProjectID
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Compliance Standard Attachment",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceComplianceStandardAttachmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `compliance_standard_id` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("enforcement_mode",
+		"exempt_accounts",
+		"exempt_compliance_checks") {
+		hasChanged++
+		req := hc.ComplianceStandardAttachmentUpdate{
+			EnforcementMode:          d.Get("enforcement_mode").(string),
+			ExemptAccountIds:         hc.FlattenGenericIDPointer(d, "exempt_accounts"),
+			ExemptComplianceCheckIds: hc.FlattenGenericIDPointer(d, "exempt_compliance_checks"),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/compliance/standard-attachment/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Compliance Standard Attachment",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceComplianceStandardAttachmentRead(ctx, d, m)
+}
+
+func resourceComplianceStandardAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/compliance/standard-attachment/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Compliance Standard Attachment",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
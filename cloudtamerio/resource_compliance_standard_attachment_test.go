@@ -0,0 +1,47 @@
+package cloudtamerio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplianceStandardAttachmentRead(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/compliance/standard-attachment/1":
+			w.Write([]byte(`{"status":200,"data":{"id":1,"compliance_standard_id":2,"enforcement_mode":"notify","exempt_accounts":[{"id":7}],"exempt_compliance_checks":[],"ou_id":0,"project_id":3,"account_id":0}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":404,"message":"Not found."}`))
+		}
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	raw := map[string]interface{}{
+		"compliance_standard_id": 2,
+		"enforcement_mode":       "auto_remediate",
+		"project_id":             3,
+	}
+
+	// Changes made in the application replace the configured values.
+	d := schema.TestResourceDataRaw(t, resourceComplianceStandardAttachment().Schema, raw)
+	d.SetId("1")
+	assert.False(t, resourceComplianceStandardAttachmentRead(context.Background(), d, c).HasError())
+	assert.Equal(t, "notify", d.Get("enforcement_mode"))
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": 7}}, d.Get("exempt_accounts"))
+
+	// A standard detached in the application is removed from state.
+	d = schema.TestResourceDataRaw(t, resourceComplianceStandardAttachment().Schema, raw)
+	d.SetId("2")
+	assert.False(t, resourceComplianceStandardAttachmentRead(context.Background(), d, c).HasError())
+	assert.Equal(t, "", d.Id())
+}
@@ -0,0 +1,289 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFundingSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFundingSourceCreate,
+		ReadContext:   resourceFundingSourceRead,
+		UpdateContext: resourceFundingSourceUpdate,
+		DeleteContext: resourceFundingSourceDelete,
+		Importer:      importWithRead("cloudtamerio_funding_source", resourceFundingSourceRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"amount": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"end_datecode": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ou_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"owner_user_groups": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"owner_users": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"permission_scheme_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"start_datecode": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceFundingSourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.FundingSourceCreate{
+		Amount:             d.Get("amount").(float64),
+		Description:        d.Get("description").(string),
+		EndDatecode:        d.Get("end_datecode").(string),
+		Name:               d.Get("name").(string),
+		OUID:               d.Get("ou_id").(int),
+		OwnerUserGroupIds:  hc.FlattenGenericIDPointer(d, "owner_user_groups"),
+		OwnerUserIds:       hc.FlattenGenericIDPointer(d, "owner_users"),
+		PermissionSchemeID: d.Get("permission_scheme_id").(int),
+		StartDatecode:      d.Get("start_datecode").(string),
+	}
+
+	resp, err := c.POST(ctx, "/v3/funding-source", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Funding Source",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Funding Source",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceFundingSourceRead(ctx, d, m)
+}
+
+func resourceFundingSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.FundingSourceResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/funding-source/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Funding Source %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Funding Source",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["amount"] = item.FundingSource.Amount
+	data["created_at"] = item.FundingSource.CreatedAt
+	data["description"] = item.FundingSource.Description
+	data["end_datecode"] = item.FundingSource.EndDatecode
+	data["name"] = item.FundingSource.Name
+	data["ou_id"] = item.FundingSource.OUID
+	if hc.InflateObjectWithID(item.OwnerUserGroups) != nil {
+		data["owner_user_groups"] = hc.InflateObjectWithID(item.OwnerUserGroups)
+	}
+	if hc.InflateObjectWithID(item.OwnerUsers) != nil {
+		data["owner_users"] = hc.InflateObjectWithID(item.OwnerUsers)
+	}
+	data["start_datecode"] = item.FundingSource.StartDatecode
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Funding Source",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceFundingSourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `ou_id` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("amount",
+		"description",
+		"end_datecode",
+		"name",
+		"start_datecode") {
+		hasChanged++
+		req := hc.FundingSourceUpdate{
+			Amount:        d.Get("amount").(float64),
+			Description:   d.Get("description").(string),
+			EndDatecode:   d.Get("end_datecode").(string),
+			Name:          d.Get("name").(string),
+			StartDatecode: d.Get("start_datecode").(string),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/funding-source/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Funding Source",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the owners have changed.
+	if d.HasChanges("owner_user_groups",
+		"owner_users") {
+		hasChanged++
+		arrAddOwnerUserGroupIds, arrRemoveOwnerUserGroupIds, _, _ := hc.AssociationChanged(d, "owner_user_groups")
+		arrAddOwnerUserIds, arrRemoveOwnerUserIds, _, _ := hc.AssociationChanged(d, "owner_users")
+
+		if len(arrAddOwnerUserGroupIds) > 0 ||
+			len(arrAddOwnerUserIds) > 0 {
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/funding-source/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
+				OwnerUserIds:      &arrAddOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to add owners on Funding Source",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+
+		if len(arrRemoveOwnerUserGroupIds) > 0 ||
+			len(arrRemoveOwnerUserIds) > 0 {
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/funding-source/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
+				OwnerUserIds:      &arrRemoveOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to remove owners on Funding Source",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceFundingSourceRead(ctx, d, m)
+}
+
+func resourceFundingSourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/funding-source/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Funding Source",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,186 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	resourceTypeFundingSource        = "cloudtamerio_funding_source"
+	resourceNameFundingSource        = "fs1"
+	dataSourceLocalNameFundingSource = "funding_sources"
+)
+
+var accTestFundingSource = hc.FundingSourceCreate{
+	Amount:             1000,
+	Description:        "sample funding source for terraform acceptance test",
+	EndDatecode:        "2030-01",
+	Name:               "Terraform AccTest Funding Source",
+	OUID:               1,
+	PermissionSchemeID: 1,
+	StartDatecode:      "2021-01",
+	OwnerUserIds:       &ownerUserIds,
+	OwnerUserGroupIds:  &ownerUserGroupIDs,
+}
+
+func TestAccResourceFundingSource(t *testing.T) {
+	fs := accTestFundingSource
+
+	// Create
+	create := resource.TestStep{
+		Config: testAccFundingSourceGenerateResourceDeclaration(&fs),
+		Check:  resource.ComposeTestCheckFunc(testAccFundingSourceCheckResource(&fs)...),
+	}
+
+	// Update
+	fs.Name = "(Updated) Terraform AccTest Funding Source"
+	fs.Amount = 2500
+	fs.EndDatecode = "2031-01"
+	update := resource.TestStep{
+		Config: testAccFundingSourceGenerateResourceDeclaration(&fs),
+		Check:  resource.ComposeTestCheckFunc(testAccFundingSourceCheckResource(&fs)...),
+	}
+
+	// Remove Owner User Group
+	fs.OwnerUserGroupIds = nil
+	removeOwnerUGroup := resource.TestStep{
+		Config: testAccFundingSourceGenerateResourceDeclaration(&fs),
+		Check:  resource.ComposeTestCheckFunc(testAccFundingSourceCheckResource(&fs)...),
+	}
+
+	// Import
+	importState := resource.TestStep{
+		ResourceName:            resourceTypeFundingSource + "." + resourceNameFundingSource,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"last_updated", "permission_scheme_id"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccFundingSourceCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			create,
+			update,
+			removeOwnerUGroup,
+			importState,
+		},
+	})
+}
+
+func TestAccDataSourceFundingSource(t *testing.T) {
+	resourceDeclaration := testAccFundingSourceGenerateResourceDeclaration(&accTestFundingSource)
+	dataSourceDeclarationAll := hc.TestAccOUGenerateDataSourceDeclarationAll(resourceTypeFundingSource, dataSourceLocalNameFundingSource)
+	dataSourceDeclarationFilter := hc.TestAccOUGenerateDataSourceDeclarationFilter(resourceTypeFundingSource, dataSourceLocalNameFundingSource, accTestFundingSource.Name)
+	dataSourceName := fmt.Sprintf("data.%v.%v", resourceTypeFundingSource, dataSourceLocalNameFundingSource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccFundingSourceCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDeclaration + "\n" + dataSourceDeclarationAll,
+				Check:  resource.TestCheckResourceAttrSet(dataSourceName, "list.#"),
+			},
+			{
+				Config: resourceDeclaration + "\n" + dataSourceDeclarationFilter,
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "filter.0.values.0", accTestFundingSource.Name),
+			},
+		},
+	})
+}
+
+// testAccFundingSourceCheckResource returns a slice of functions that validate the test resource's fields
+func testAccFundingSourceCheckResource(fs *hc.FundingSourceCreate) (funcs []resource.TestCheckFunc) {
+	name := resourceTypeFundingSource + "." + resourceNameFundingSource
+
+	funcs = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(name, "amount", fmt.Sprint(fs.Amount)),
+		resource.TestCheckResourceAttr(name, "description", fs.Description),
+		resource.TestCheckResourceAttr(name, "end_datecode", fs.EndDatecode),
+		resource.TestCheckResourceAttr(name, "name", fs.Name),
+		resource.TestCheckResourceAttr(name, "ou_id", fmt.Sprint(fs.OUID)),
+		resource.TestCheckResourceAttr(name, "start_datecode", fs.StartDatecode),
+	}
+
+	funcs = append(funcs, hc.GenerateAccTestChecksForResourceOwners(
+		resourceTypeFundingSource,
+		resourceNameFundingSource,
+		fs.OwnerUserIds,
+		fs.OwnerUserGroupIds,
+	)...)
+
+	return
+}
+
+// testAccFundingSourceGenerateResourceDeclaration generates a resource declaration string (a la main.tf)
+func testAccFundingSourceGenerateResourceDeclaration(fs *hc.FundingSourceCreate) string {
+	if fs == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+		resource "%v" "%v" {
+			amount               = %v
+			description          = "%v"
+			end_datecode         = "%v"
+			name                 = "%v"
+			ou_id                = %v
+			permission_scheme_id = %v
+			start_datecode       = "%v"
+			%v
+		}`,
+		resourceTypeFundingSource, resourceNameFundingSource,
+		fs.Amount,
+		fs.Description,
+		fs.EndDatecode,
+		fs.Name,
+		fs.OUID,
+		fs.PermissionSchemeID,
+		fs.StartDatecode,
+		hc.GenerateOwnerClausesForResourceTest(fs.OwnerUserIds, fs.OwnerUserGroupIds),
+	)
+}
+
+// testAccFundingSourceCheckResourceDestroy verifies the resource has been destroyed
+func testAccFundingSourceCheckResourceDestroy(s *terraform.State) error {
+	// retrieve the connection established in Provider configuration
+	meta := testAccProvider.Meta()
+	if meta == nil {
+		return nil
+	}
+
+	c := meta.(*hc.Client)
+
+	// loop through the resources in state, verifying each resource is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceTypeFundingSource {
+			continue
+		}
+
+		resp := new(hc.FundingSourceResponse)
+		err := c.GET(context.Background(), fmt.Sprintf("/v3/funding-source/%s", rs.Primary.ID), resp)
+		if err == nil {
+			if fmt.Sprint(resp.Data.FundingSource.ID) == rs.Primary.ID {
+				return fmt.Errorf("Funding Source (%s) still exists.", rs.Primary.ID)
+			}
+
+			return nil
+		}
+
+		// If the error is equivalent to 404 not found, the resource is destroyed.
+		// Otherwise, return the error
+		if !hc.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceGcpIamRole() *schema.Resource {
 		ReadContext:   resourceGcpIamRoleRead,
 		UpdateContext: resourceGcpIamRoleUpdate,
 		DeleteContext: resourceGcpIamRoleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceGcpIamRoleRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_gcp_iam_role", resourceGcpIamRoleRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -101,7 +103,7 @@ func resourceGcpIamRoleCreate(ctx context.Context, d *schema.ResourceData, m int
 		GCPRoleLaunchStage: d.Get("gcp_role_launch_stage").(int),
 	}
 
-	resp, err := c.POST("/v3/gcp-iam-role", post)
+	resp, err := c.POST(ctx, "/v3/gcp-iam-role", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -131,8 +133,13 @@ func resourceGcpIamRoleRead(ctx context.Context, d *schema.ResourceData, m inter
 	ID := d.Id()
 
 	resp := new(hc.GCPRoleResponseWithOwners)
-	err := c.GET(fmt.Sprintf("/v3/gcp-iam-role/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/gcp-iam-role/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] GcpIamRole %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read GcpIamRole",
@@ -195,7 +202,7 @@ func resourceGcpIamRoleUpdate(ctx context.Context, d *schema.ResourceData, m int
 			RolePermissions:    hc.FlattenStringArray(d.Get("role_permissions").([]interface{})),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/gcp-iam-role/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/gcp-iam-role/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -215,7 +222,7 @@ func resourceGcpIamRoleUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/gcp-iam-role/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/gcp-iam-role/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -231,7 +238,7 @@ func resourceGcpIamRoleUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/gcp-iam-role/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/gcp-iam-role/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -258,7 +265,7 @@ func resourceGcpIamRoleDelete(ctx context.Context, d *schema.ResourceData, m int
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/gcp-iam-role/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/gcp-iam-role/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
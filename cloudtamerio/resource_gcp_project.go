@@ -0,0 +1,270 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGcpProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGcpProjectCreate,
+		ReadContext:   resourceGcpProjectRead,
+		UpdateContext: resourceGcpProjectUpdate,
+		DeleteContext: resourceGcpProjectDelete,
+		Importer:      importWithRead("cloudtamerio_gcp_project", resourceGcpProjectRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"account_type_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gcp_project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"move_datecode": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"move_financials": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "move",
+				ValidateFunc: validation.StringInSlice([]string{"move", "preserve"}, false),
+			},
+			"labels": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"payer_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"skip_access_checking": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"start_datecode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+		},
+	}
+}
+
+func resourceGcpProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.GcpProjectImport{
+		AccountTypeID:      hc.FlattenIntPointer(d, "account_type_id"),
+		GcpProjectID:       d.Get("gcp_project_id").(string),
+		LabelIds:           hc.FlattenGenericIDPointer(d, "labels"),
+		Name:               d.Get("name").(string),
+		PayerID:            d.Get("payer_id").(int),
+		ProjectID:          d.Get("project_id").(int),
+		SkipAccessChecking: d.Get("skip_access_checking").(bool),
+		StartDatecode:      hc.FlattenStringPointer(d, "start_datecode"),
+	}
+
+	resp, err := c.POST(ctx, "/v3/account?account-type=gcp", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create GCP Project",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create GCP Project",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceGcpProjectRead(ctx, d, m)
+}
+
+func resourceGcpProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.GcpProjectResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/account/%s?account-type=gcp", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] GCP Project %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read GCP Project",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["account_type_id"] = item.AccountTypeID
+	data["created_at"] = item.CreatedAt
+	data["gcp_project_id"] = item.GcpProjectID
+	data["labels"] = inflateLabels(item.Labels)
+	data["name"] = item.Name
+	data["payer_id"] = item.PayerID
+	data["project_id"] = item.ProjectID
+	data["skip_access_checking"] = item.SkipAccessChecking
+	data["start_datecode"] = item.StartDatecode
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set GCP Project",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceGcpProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `gcp_project_id` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("name",
+		"payer_id",
+		"skip_access_checking") {
+		hasChanged++
+		req := hc.GcpProjectUpdate{
+			Name:               d.Get("name").(string),
+			PayerID:            d.Get("payer_id").(int),
+			SkipAccessChecking: d.Get("skip_access_checking").(bool),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/account/%s?account-type=gcp", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update GCP Project",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the Google Cloud project moved to another project.
+	if d.HasChange("project_id") {
+		hasChanged++
+		req := hc.AccountMove{
+			Financials:   d.Get("move_financials").(string),
+			MoveDatecode: hc.FlattenStringPointer(d, "move_datecode"),
+			ProjectID:    d.Get("project_id").(int),
+		}
+
+		_, err := c.POST(ctx, fmt.Sprintf("/v3/account/%s/move?account-type=gcp", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to move GCP Project",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the labels have changed.
+	diags, hasChanged = LabelChanges(ctx, c, d, diags, hasChanged, fmt.Sprintf("/v3/account/%s", ID), "GCP Project")
+	if len(diags) > 0 {
+		return diags
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceGcpProjectRead(ctx, d, m)
+}
+
+func resourceGcpProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	// This removes the project from cloudtamer.io, it does not shut down the
+	// project in Google Cloud.
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/account/%s?account-type=gcp", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete GCP Project",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,286 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdmsLdap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdmsLdapCreate,
+		ReadContext:   resourceIdmsLdapRead,
+		UpdateContext: resourceIdmsLdapUpdate,
+		DeleteContext: resourceIdmsLdapDelete,
+		Importer:      importWithRead("cloudtamerio_idms_ldap", resourceIdmsLdapRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"base_dn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bind_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"bind_user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"email_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "mail",
+			},
+			"first_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "givenName",
+			},
+			"group_base_dn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_member_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "member",
+			},
+			"group_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cn",
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idms_type_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sn",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  389,
+			},
+			"skip_tls_verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"use_tls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"user_base_dn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"username_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "uid",
+			},
+		},
+	}
+}
+
+func resourceIdmsLdapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := expandIdmsLdap(d)
+
+	resp, err := c.POST(ctx, "/v3/idms?idms-type=ldap", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create LDAP IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Name),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create LDAP IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Name),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceIdmsLdapRead(ctx, d, m)
+}
+
+func resourceIdmsLdapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.IdmsLdapResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/idms/%s?idms-type=ldap", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] LDAP IDMS %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read LDAP IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	// The bind password is never returned so it is kept from the configuration.
+	data := make(map[string]interface{})
+	data["base_dn"] = item.BaseDN
+	data["bind_user"] = item.BindUser
+	data["email_attribute"] = item.EmailAttribute
+	data["first_name_attribute"] = item.FirstNameAttribute
+	data["group_base_dn"] = item.GroupBaseDN
+	data["group_member_attribute"] = item.GroupMemberAttribute
+	data["group_name_attribute"] = item.GroupNameAttribute
+	data["host"] = item.Host
+	data["idms_type_id"] = item.IdmsTypeID
+	data["last_name_attribute"] = item.LastNameAttribute
+	data["name"] = item.Name
+	data["port"] = item.Port
+	data["skip_tls_verify"] = item.SkipTLSVerify
+	data["use_tls"] = item.UseTLS
+	data["user_base_dn"] = item.UserBaseDN
+	data["username_attribute"] = item.UsernameAttribute
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set LDAP IDMS",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceIdmsLdapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	if d.HasChanges("base_dn",
+		"bind_password",
+		"bind_user",
+		"email_attribute",
+		"first_name_attribute",
+		"group_base_dn",
+		"group_member_attribute",
+		"group_name_attribute",
+		"host",
+		"last_name_attribute",
+		"name",
+		"port",
+		"skip_tls_verify",
+		"use_tls",
+		"user_base_dn",
+		"username_attribute") {
+		hasChanged++
+		req := expandIdmsLdap(d)
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/idms/%s?idms-type=ldap", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update LDAP IDMS",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceIdmsLdapRead(ctx, d, m)
+}
+
+func resourceIdmsLdapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/idms/%s?idms-type=ldap", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete LDAP IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// expandIdmsLdap builds the request for both create and update.
+func expandIdmsLdap(d *schema.ResourceData) hc.IdmsLdapCreate {
+	return hc.IdmsLdapCreate{
+		BaseDN:               d.Get("base_dn").(string),
+		BindPassword:         d.Get("bind_password").(string),
+		BindUser:             d.Get("bind_user").(string),
+		EmailAttribute:       d.Get("email_attribute").(string),
+		FirstNameAttribute:   d.Get("first_name_attribute").(string),
+		GroupBaseDN:          d.Get("group_base_dn").(string),
+		GroupMemberAttribute: d.Get("group_member_attribute").(string),
+		GroupNameAttribute:   d.Get("group_name_attribute").(string),
+		Host:                 d.Get("host").(string),
+		LastNameAttribute:    d.Get("last_name_attribute").(string),
+		Name:                 d.Get("name").(string),
+		Port:                 d.Get("port").(int),
+		SkipTLSVerify:        d.Get("skip_tls_verify").(bool),
+		UseTLS:               d.Get("use_tls").(bool),
+		UserBaseDN:           d.Get("user_base_dn").(string),
+		UsernameAttribute:    d.Get("username_attribute").(string),
+	}
+}
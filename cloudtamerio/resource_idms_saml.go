@@ -0,0 +1,243 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdmsSaml() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdmsSamlCreate,
+		ReadContext:   resourceIdmsSamlRead,
+		UpdateContext: resourceIdmsSamlUpdate,
+		DeleteContext: resourceIdmsSamlDelete,
+		Importer:      importWithRead("cloudtamerio_idms_saml", resourceIdmsSamlRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"first_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"groups_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"idms_type_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"metadata_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_url", "metadata_xml"},
+			},
+			"metadata_xml": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIdmsSamlCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := expandIdmsSaml(d)
+
+	resp, err := c.POST(ctx, "/v3/idms?idms-type=saml", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create SAML IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Name),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create SAML IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Name),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceIdmsSamlRead(ctx, d, m)
+}
+
+func resourceIdmsSamlRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.IdmsSamlResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/idms/%s?idms-type=saml", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] SAML IDMS %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read SAML IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["email_attribute"] = item.EmailAttribute
+	data["entity_id"] = item.EntityID
+	data["first_name_attribute"] = item.FirstNameAttribute
+	data["groups_attribute"] = item.GroupsAttribute
+	data["idms_type_id"] = item.IdmsTypeID
+	data["last_name_attribute"] = item.LastNameAttribute
+	data["metadata_url"] = item.MetadataURL
+	data["metadata_xml"] = item.MetadataXML
+	data["name"] = item.Name
+	data["username_attribute"] = item.UsernameAttribute
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set SAML IDMS",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceIdmsSamlUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	if d.HasChanges("email_attribute",
+		"entity_id",
+		"first_name_attribute",
+		"groups_attribute",
+		"last_name_attribute",
+		"metadata_url",
+		"metadata_xml",
+		"name",
+		"username_attribute") {
+		hasChanged++
+		req := expandIdmsSaml(d)
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/idms/%s?idms-type=saml", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update SAML IDMS",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceIdmsSamlRead(ctx, d, m)
+}
+
+func resourceIdmsSamlDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/idms/%s?idms-type=saml", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete SAML IDMS",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// expandIdmsSaml builds the request for both create and update.
+func expandIdmsSaml(d *schema.ResourceData) hc.IdmsSamlCreate {
+	req := hc.IdmsSamlCreate{
+		EmailAttribute:     d.Get("email_attribute").(string),
+		EntityID:           d.Get("entity_id").(string),
+		FirstNameAttribute: d.Get("first_name_attribute").(string),
+		GroupsAttribute:    d.Get("groups_attribute").(string),
+		LastNameAttribute:  d.Get("last_name_attribute").(string),
+		MetadataURL:        hc.FlattenStringPointer(d, "metadata_url"),
+		Name:               d.Get("name").(string),
+		UsernameAttribute:  d.Get("username_attribute").(string),
+	}
+
+	// The metadata XML is computed from the URL, so it is only sent when
+	// there is no URL.
+	if req.MetadataURL == nil {
+		req.MetadataXML = hc.FlattenStringPointer(d, "metadata_xml")
+	}
+
+	return req
+}
@@ -0,0 +1,35 @@
+package cloudtamerio
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandIdmsSaml(t *testing.T) {
+	// Metadata from a URL
+	d := schema.TestResourceDataRaw(t, resourceIdmsSaml().Schema, map[string]interface{}{
+		"entity_id":    "https://ct.example.com",
+		"metadata_url": "https://idp.example.com/metadata",
+		"name":         "Okta",
+	})
+	d.Set("metadata_xml", "<EntityDescriptor/>")
+	req := expandIdmsSaml(d)
+	if assert.NotNil(t, req.MetadataURL) {
+		assert.Equal(t, "https://idp.example.com/metadata", *req.MetadataURL)
+	}
+	assert.Nil(t, req.MetadataXML)
+
+	// Metadata as XML
+	d = schema.TestResourceDataRaw(t, resourceIdmsSaml().Schema, map[string]interface{}{
+		"entity_id":    "https://ct.example.com",
+		"metadata_xml": "<EntityDescriptor/>",
+		"name":         "Okta",
+	})
+	req = expandIdmsSaml(d)
+	assert.Nil(t, req.MetadataURL)
+	if assert.NotNil(t, req.MetadataXML) {
+		assert.Equal(t, "<EntityDescriptor/>", *req.MetadataXML)
+	}
+}
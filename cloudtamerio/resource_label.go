@@ -0,0 +1,254 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLabelCreate,
+		ReadContext:   resourceLabelRead,
+		UpdateContext: resourceLabelUpdate,
+		DeleteContext: resourceLabelDelete,
+		Importer:      importWithRead("cloudtamerio_label", resourceLabelRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"color": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a hex color like #1A2B3C"),
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceLabelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.LabelCreate{
+		Color: d.Get("color").(string),
+		Key:   d.Get("key").(string),
+		Value: d.Get("value").(string),
+	}
+
+	resp, err := c.POST(ctx, "/v3/label", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Label",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Key),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Label",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Key),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceLabelRead(ctx, d, m)
+}
+
+func resourceLabelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.LabelResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/label/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Label %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Label",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["color"] = item.Color
+	data["key"] = item.Key
+	data["value"] = item.Value
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Label",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceLabelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	if d.HasChanges("color",
+		"key",
+		"value") {
+		hasChanged++
+		req := hc.LabelUpdate{
+			Color: d.Get("color").(string),
+			Key:   d.Get("key").(string),
+			Value: d.Get("value").(string),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/label/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Label",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceLabelRead(ctx, d, m)
+}
+
+func resourceLabelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/label/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Label",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// LabelChanges adds and removes the labels on an OU, project, or account. The
+// path is the item the labels are on, like /v3/ou/1, and the label is used in
+// the error messages.
+func LabelChanges(ctx context.Context, c *hc.Client, d *schema.ResourceData, diags diag.Diagnostics, hasChanged int, path string, label string) (diag.Diagnostics, int) {
+	if !d.HasChange("labels") {
+		return diags, hasChanged
+	}
+
+	hasChanged++
+	arrAddLabelIds, arrRemoveLabelIds, _, _ := hc.AssociationChanged(d, "labels")
+
+	if len(arrAddLabelIds) > 0 {
+		_, err := c.POST(ctx, fmt.Sprintf("%s/label", path), hc.ChangeLabels{
+			LabelIds: &arrAddLabelIds,
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to add labels on %s", label),
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), d.Id()),
+			})
+			return diags, hasChanged
+		}
+	}
+
+	if len(arrRemoveLabelIds) > 0 {
+		err := c.DELETE(ctx, fmt.Sprintf("%s/label", path), hc.ChangeLabels{
+			LabelIds: &arrRemoveLabelIds,
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to remove labels on %s", label),
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), d.Id()),
+			})
+			return diags, hasChanged
+		}
+	}
+
+	return diags, hasChanged
+}
+
+// inflateLabels returns the label IDs for the labels block of a resource.
+func inflateLabels(arr []hc.ObjectWithLabel) []interface{} {
+	final := make([]interface{}, 0)
+	for _, item := range arr {
+		final = append(final, map[string]interface{}{
+			"id": item.ID,
+		})
+	}
+
+	return final
+}
+
+// inflateLabelList returns the full labels for the list of a data source so
+// they can be filtered on with labels.key and labels.value.
+func inflateLabelList(arr []hc.ObjectWithLabel) []interface{} {
+	final := make([]interface{}, 0)
+	for _, item := range arr {
+		final = append(final, map[string]interface{}{
+			"color": item.Color,
+			"id":    item.ID,
+			"key":   item.Key,
+			"value": item.Value,
+		})
+	}
+
+	return final
+}
@@ -0,0 +1,61 @@
+package cloudtamerio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelChanges(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"status":200,"record_id":0}`))
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	// The OU has labels 1 and 2 and the configuration has labels 2 and 3.
+	state := &terraform.InstanceState{
+		ID: "4",
+		Attributes: map[string]string{
+			"labels.#":    "2",
+			"labels.0.id": "1",
+			"labels.1.id": "2",
+		},
+	}
+	d, err := schema.InternalMap(resourceOU().Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"labels.0.id": {Old: "1", New: "2"},
+			"labels.1.id": {Old: "2", New: "3"},
+		},
+	})
+	assert.NoError(t, err)
+
+	diags, hasChanged := LabelChanges(context.Background(), c, d, nil, 0, "/v3/ou/4", "OU")
+	assert.Empty(t, diags)
+	assert.Equal(t, 1, hasChanged)
+	assert.Equal(t, []string{
+		`POST /api/v3/ou/4/label {"label_ids":[3]}`,
+		`DELETE /api/v3/ou/4/label {"label_ids":[1]}`,
+	}, requests)
+
+	// Nothing is sent when the labels are the same.
+	requests = nil
+	d, err = schema.InternalMap(resourceOU().Schema).Data(state, nil)
+	assert.NoError(t, err)
+	diags, hasChanged = LabelChanges(context.Background(), c, d, nil, 0, "/v3/ou/4", "OU")
+	assert.Empty(t, diags)
+	assert.Equal(t, 0, hasChanged)
+	assert.Empty(t, requests)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceOU() *schema.Resource {
 		ReadContext:   resourceOURead,
 		UpdateContext: resourceOUUpdate,
 		DeleteContext: resourceOUDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceOURead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_ou", resourceOURead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -39,6 +41,18 @@ func resourceOU() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"labels": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -86,6 +100,7 @@ func resourceOUCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	post := hc.OUCreate{
 		Description:        d.Get("description").(string),
+		LabelIds:           hc.FlattenGenericIDPointer(d, "labels"),
 		Name:               d.Get("name").(string),
 		OwnerUserGroupIds:  hc.FlattenGenericIDPointer(d, "owner_user_groups"),
 		OwnerUserIds:       hc.FlattenGenericIDPointer(d, "owner_users"),
@@ -93,7 +108,7 @@ func resourceOUCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 		PermissionSchemeID: d.Get("permission_scheme_id").(int),
 	}
 
-	resp, err := c.POST("/v3/ou", post)
+	resp, err := c.POST(ctx, "/v3/ou", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -123,8 +138,13 @@ func resourceOURead(ctx context.Context, d *schema.ResourceData, m interface{})
 	ID := d.Id()
 
 	resp := new(hc.OUResponse)
-	err := c.GET(fmt.Sprintf("/v3/ou/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/ou/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] OU %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read OU",
@@ -137,6 +157,7 @@ func resourceOURead(ctx context.Context, d *schema.ResourceData, m interface{})
 	data := make(map[string]interface{})
 	data["created_at"] = item.OU.CreatedAt
 	data["description"] = item.OU.Description
+	data["labels"] = inflateLabels(item.Labels)
 	data["name"] = item.OU.Name
 	if hc.InflateObjectWithID(item.OwnerUserGroups) != nil {
 		data["owner_user_groups"] = hc.InflateObjectWithID(item.OwnerUserGroups)
@@ -182,7 +203,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 			PermissionSchemeID: d.Get("permission_scheme_id").(int),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/ou/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/ou/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -195,7 +216,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	// Allow moving an OU if the parent ID changes and updating permissions.
 	// Don't let codegen remove this.
-	diags, hasChanged = OUChanges(c, d, diags, hasChanged)
+	diags, hasChanged = OUChanges(ctx, c, d, diags, hasChanged)
 	if len(diags) > 0 {
 		return diags
 	}
@@ -209,7 +230,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -225,7 +246,7 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/ou/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -240,6 +261,12 @@ func resourceOUUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 		}
 	}
 
+	// Determine if the labels have changed.
+	diags, hasChanged = LabelChanges(ctx, c, d, diags, hasChanged, fmt.Sprintf("/v3/ou/%s", ID), "OU")
+	if len(diags) > 0 {
+		return diags
+	}
+
 	if hasChanged > 0 {
 		d.Set("last_updated", time.Now().Format(time.RFC850))
 	}
@@ -252,7 +279,7 @@ func resourceOUDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v2/ou/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v2/ou/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
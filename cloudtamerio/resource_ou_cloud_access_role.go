@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceOUCloudAccessRole() *schema.Resource {
 		ReadContext:   resourceOUCloudAccessRoleRead,
 		UpdateContext: resourceOUCloudAccessRoleUpdate,
 		DeleteContext: resourceOUCloudAccessRoleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceOUCloudAccessRoleRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_ou_cloud_access_role", resourceOUCloudAccessRoleRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -124,7 +126,7 @@ func resourceOUCloudAccessRoleCreate(ctx context.Context, d *schema.ResourceData
 		WebAccess:                 d.Get("web_access").(bool),
 	}
 
-	resp, err := c.POST("/v3/ou-cloud-access-role", post)
+	resp, err := c.POST(ctx, "/v3/ou-cloud-access-role", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -154,8 +156,13 @@ func resourceOUCloudAccessRoleRead(ctx context.Context, d *schema.ResourceData,
 	ID := d.Id()
 
 	resp := new(hc.OUCloudAccessRoleResponse)
-	err := c.GET(fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] OUCloudAccessRole %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read OUCloudAccessRole",
@@ -223,7 +230,7 @@ func resourceOUCloudAccessRoleUpdate(ctx context.Context, d *schema.ResourceData
 			WebAccess:           d.Get("web_access").(bool),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -249,7 +256,7 @@ func resourceOUCloudAccessRoleUpdate(ctx context.Context, d *schema.ResourceData
 			len(arrAddAwsIamPolicies) > 0 ||
 			len(arrAddUserGroupIds) > 0 ||
 			len(arrAddUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), hc.OUCloudAccessRoleAssociationsAdd{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), hc.OUCloudAccessRoleAssociationsAdd{
 				AwsIamPermissionsBoundary: arrAddAwsIamPermissionsBoundary,
 				AwsIamPolicies:            &arrAddAwsIamPolicies,
 				UserGroupIds:              &arrAddUserGroupIds,
@@ -269,7 +276,7 @@ func resourceOUCloudAccessRoleUpdate(ctx context.Context, d *schema.ResourceData
 			len(arrRemoveAwsIamPolicies) > 0 ||
 			len(arrRemoveUserGroupIds) > 0 ||
 			len(arrRemoveUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), hc.OUCloudAccessRoleAssociationsRemove{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s/association", ID), hc.OUCloudAccessRoleAssociationsRemove{
 				AwsIamPermissionsBoundary: arrRemoveAwsIamPermissionsBoundary,
 				AwsIamPolicies:            &arrRemoveAwsIamPolicies,
 				UserGroupIds:              &arrRemoveUserGroupIds,
@@ -298,7 +305,7 @@ func resourceOUCloudAccessRoleDelete(ctx context.Context, d *schema.ResourceData
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/ou-cloud-access-role/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -1,6 +1,7 @@
 package cloudtamerio
 
 import (
+	"context"
 	"fmt"
 
 	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
@@ -9,7 +10,7 @@ import (
 )
 
 // OUChanges allows moving an OU if the parent ID changes and updating permissions.
-func OUChanges(c *hc.Client, d *schema.ResourceData, diags diag.Diagnostics, hasChanged int) (diag.Diagnostics, int) {
+func OUChanges(ctx context.Context, c *hc.Client, d *schema.ResourceData, diags diag.Diagnostics, hasChanged int) (diag.Diagnostics, int) {
 	// Handle OU move.
 	if d.HasChanges("parent_ou_id") {
 		hasChanged++
@@ -22,7 +23,7 @@ func OUChanges(c *hc.Client, d *schema.ResourceData, diags diag.Diagnostics, has
 			})
 			return diags, hasChanged
 		}
-		_, err = c.POST(fmt.Sprintf("/v2/ou/%s/move", d.Id()), arrParentOUID)
+		_, err = c.POST(ctx, fmt.Sprintf("/v2/ou/%s/move", d.Id()), arrParentOUID)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -1,9 +1,8 @@
 package cloudtamerio
 
 import (
+	"context"
 	"fmt"
-	"net/http"
-	"strings"
 	"testing"
 
 	"github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
@@ -153,7 +152,7 @@ func testAccOUCheckResourceDestroy(s *terraform.State) error {
 
 		// Retrieve our resource by referencing it's state ID for API lookup
 		resp := new(hc.OUResponse)
-		err := c.GET(fmt.Sprintf("/v3/ou/%s", rs.Primary.ID), resp)
+		err := c.GET(context.Background(), fmt.Sprintf("/v3/ou/%s", rs.Primary.ID), resp)
 		if err == nil {
 			if fmt.Sprint(resp.Data.OU.ID) == rs.Primary.ID {
 				return fmt.Errorf("OU (%s) still exists.", rs.Primary.ID)
@@ -164,7 +163,7 @@ func testAccOUCheckResourceDestroy(s *terraform.State) error {
 
 		// If the error is equivalent to 404 not found, the resource is destroyed.
 		// Otherwise, return the error
-		if !strings.Contains(err.Error(), fmt.Sprintf("status: %d", http.StatusNotFound)) {
+		if !hc.IsNotFound(err) {
 			return err
 		}
 	}
@@ -0,0 +1,402 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// permissionMapping describes the item that owns the permission mappings
+// because OUs and projects share the same API.
+type permissionMapping struct {
+	resourceType string // Terraform resource type.
+	field        string // Field that holds the ID of the OU or project.
+	path         string // API path of the OU or project.
+	label        string // Name used in diagnostics.
+}
+
+func resourceOUPermissionMapping() *schema.Resource {
+	return resourcePermissionMapping(permissionMapping{
+		resourceType: "cloudtamerio_ou_permission_mapping",
+		field:        "ou_id",
+		path:         "ou",
+		label:        "OU Permission Mapping",
+	})
+}
+
+func resourceProjectPermissionMapping() *schema.Resource {
+	return resourcePermissionMapping(permissionMapping{
+		resourceType: "cloudtamerio_project_permission_mapping",
+		field:        "project_id",
+		path:         "project",
+		label:        "Project Permission Mapping",
+	})
+}
+
+func resourcePermissionMapping(pm permissionMapping) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pm.create,
+		ReadContext:   pm.read,
+		UpdateContext: pm.update,
+		DeleteContext: pm.delete,
+		Importer:      importWithRead(pm.resourceType, pm.read),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"app_role_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "authoritative",
+				ValidateFunc: validation.StringInSlice([]string{"authoritative", "additive"}, false),
+			},
+			pm.field: {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"user_groups": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"user_groups", "users"},
+			},
+			"users": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (pm permissionMapping) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	parentID := d.Get(pm.field).(int)
+	appRoleID := d.Get("app_role_id").(int)
+
+	// The mapping is keyed by the OU or project and the app role because
+	// there is one mapping for each app role.
+	d.SetId(fmt.Sprintf("%d/%d", parentID, appRoleID))
+
+	err := pm.sync(ctx, c, d, nil, nil)
+	if err != nil {
+		d.SetId("")
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to create %s", pm.label),
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), fmt.Sprintf("%d/%d", parentID, appRoleID)),
+		})
+		return diags
+	}
+
+	return pm.read(ctx, d, m)
+}
+
+func (pm permissionMapping) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	parentID, appRoleID, err := parsePermissionMappingID(ID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to read %s", pm.label),
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	userGroupIDs, userIDs, found, err := pm.current(ctx, c, parentID, appRoleID)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] %s %s was not found, removing from state", pm.label, ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to read %s", pm.label),
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	} else if !found {
+		log.Printf("[WARN] %s %s was not found, removing from state", pm.label, ID)
+		d.SetId("")
+		return diags
+	}
+
+	// The mode is not stored in cloudtamer.io so imports are authoritative.
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		mode = "authoritative"
+	}
+
+	// In additive mode only the users and groups managed by this resource are
+	// tracked, so additions made elsewhere do not show up as drift.
+	if mode == "additive" {
+		userGroupIDs = intersectIDs(hc.FlattenGenericIDArray(d, "user_groups"), userGroupIDs)
+		userIDs = intersectIDs(hc.FlattenGenericIDArray(d, "users"), userIDs)
+	} else {
+		userGroupIDs = orderIDsLike(hc.FlattenGenericIDArray(d, "user_groups"), userGroupIDs)
+		userIDs = orderIDsLike(hc.FlattenGenericIDArray(d, "users"), userIDs)
+	}
+
+	data := make(map[string]interface{})
+	data["app_role_id"] = appRoleID
+	data["mode"] = mode
+	data[pm.field] = parentID
+	data["user_groups"] = inflateIDs(userGroupIDs)
+	data["users"] = inflateIDs(userIDs)
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to read and set %s", pm.label),
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (pm permissionMapping) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the users or groups have changed.
+	if d.HasChanges("mode",
+		"user_groups",
+		"users") {
+		hasChanged++
+
+		// Users and groups removed from the configuration are removed in both
+		// modes.
+		_, removeUserGroupIds, _, _ := hc.AssociationChanged(d, "user_groups")
+		_, removeUserIds, _, _ := hc.AssociationChanged(d, "users")
+
+		err := pm.sync(ctx, c, d, removeUserGroupIds, removeUserIds)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Unable to update %s", pm.label),
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return pm.read(ctx, d, m)
+}
+
+func (pm permissionMapping) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	parentID, appRoleID, err := parsePermissionMappingID(ID)
+	if err == nil {
+		var userGroupIDs, userIDs []int
+		userGroupIDs, userIDs, _, err = pm.current(ctx, c, parentID, appRoleID)
+		if err == nil {
+			// Additive mappings only remove the users and groups they added.
+			if d.Get("mode").(string) == "additive" {
+				userGroupIDs = intersectIDs(hc.FlattenGenericIDArray(d, "user_groups"), userGroupIDs)
+				userIDs = intersectIDs(hc.FlattenGenericIDArray(d, "users"), userIDs)
+			}
+			err = pm.remove(ctx, c, parentID, appRoleID, userGroupIDs, userIDs)
+		}
+	}
+	if err != nil && !hc.IsNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Unable to delete %s", pm.label),
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// sync adds the configured users and groups that are missing. In
+// authoritative mode it also removes every other user and group, in additive
+// mode it only removes the given users and groups.
+func (pm permissionMapping) sync(ctx context.Context, c *hc.Client, d *schema.ResourceData, removeUserGroupIDs []int, removeUserIDs []int) error {
+	parentID := d.Get(pm.field).(int)
+	appRoleID := d.Get("app_role_id").(int)
+	wantUserGroupIDs := hc.FlattenGenericIDArray(d, "user_groups")
+	wantUserIDs := hc.FlattenGenericIDArray(d, "users")
+
+	userGroupIDs, userIDs, _, err := pm.current(ctx, c, parentID, appRoleID)
+	if err != nil {
+		return err
+	}
+
+	addUserGroupIDs := subtractIDs(wantUserGroupIDs, userGroupIDs)
+	addUserIDs := subtractIDs(wantUserIDs, userIDs)
+	if len(addUserGroupIDs) > 0 || len(addUserIDs) > 0 {
+		_, err := c.POST(ctx, fmt.Sprintf("/v3/%s/%d/permission-mapping", pm.path, parentID), hc.OUPermissionAdd{
+			AppRoleID:         &appRoleID,
+			OwnerUserGroupIds: &addUserGroupIDs,
+			OwnerUserIds:      &addUserIDs,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.Get("mode").(string) == "authoritative" {
+		removeUserGroupIDs = subtractIDs(userGroupIDs, wantUserGroupIDs)
+		removeUserIDs = subtractIDs(userIDs, wantUserIDs)
+	} else {
+		removeUserGroupIDs = intersectIDs(removeUserGroupIDs, userGroupIDs)
+		removeUserIDs = intersectIDs(removeUserIDs, userIDs)
+	}
+
+	return pm.remove(ctx, c, parentID, appRoleID, removeUserGroupIDs, removeUserIDs)
+}
+
+// current returns the users and groups that have the app role on the OU or
+// project.
+func (pm permissionMapping) current(ctx context.Context, c *hc.Client, parentID int, appRoleID int) ([]int, []int, bool, error) {
+	resp := new(hc.OUPermissionListResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/%s/%d/permission-mapping", pm.path, parentID), resp)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	for _, item := range resp.Data {
+		if item.AppRoleID == appRoleID {
+			return item.UserGroupsIds, item.UserIds, true, nil
+		}
+	}
+
+	return []int{}, []int{}, false, nil
+}
+
+// remove takes the app role away from the users and groups.
+func (pm permissionMapping) remove(ctx context.Context, c *hc.Client, parentID int, appRoleID int, userGroupIDs []int, userIDs []int) error {
+	if len(userGroupIDs) == 0 && len(userIDs) == 0 {
+		return nil
+	}
+
+	return c.DELETE(ctx, fmt.Sprintf("/v3/%s/%d/permission-mapping", pm.path, parentID), hc.OUPermissionRemove{
+		AppRoleID:         &appRoleID,
+		OwnerUserGroupIds: &userGroupIDs,
+		OwnerUserIds:      &userIDs,
+	})
+}
+
+// parsePermissionMappingID splits an ID in the format parent_id/app_role_id.
+func parsePermissionMappingID(ID string) (int, int, error) {
+	parts := strings.Split(ID, "/")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("the ID must be in the format parent_id/app_role_id")
+	}
+
+	parentID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the parent ID is not a number: %v", parts[0])
+	}
+
+	appRoleID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the app role ID is not a number: %v", parts[1])
+	}
+
+	return parentID, appRoleID, nil
+}
+
+// subtractIDs returns the IDs in a that are not in b.
+func subtractIDs(a []int, b []int) []int {
+	m := make(map[int]bool)
+	for _, v := range b {
+		m[v] = true
+	}
+
+	arr := make([]int, 0)
+	for _, v := range a {
+		if !m[v] {
+			arr = append(arr, v)
+		}
+	}
+
+	return arr
+}
+
+// intersectIDs returns the IDs in a that are also in b.
+func intersectIDs(a []int, b []int) []int {
+	return subtractIDs(a, subtractIDs(a, b))
+}
+
+// orderIDsLike returns ids with the IDs that are in order first, in the same
+// order, so a list that only differs in order does not show a diff.
+func orderIDsLike(order []int, ids []int) []int {
+	arr := intersectIDs(order, ids)
+	return append(arr, subtractIDs(ids, arr)...)
+}
+
+// inflateIDs converts IDs to blocks with an id field.
+func inflateIDs(ids []int) []interface{} {
+	arr := make([]interface{}, 0, len(ids))
+	for _, v := range ids {
+		arr = append(arr, map[string]interface{}{"id": v})
+	}
+
+	return arr
+}
@@ -0,0 +1,70 @@
+package cloudtamerio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionMappingIDs(t *testing.T) {
+	assert.Equal(t, []int{1, 3}, subtractIDs([]int{1, 2, 3}, []int{2, 4}))
+	assert.Equal(t, []int{2}, intersectIDs([]int{1, 2, 3}, []int{2, 4}))
+	assert.Equal(t, []int{3, 1, 5}, orderIDsLike([]int{3, 2, 1}, []int{1, 3, 5}))
+
+	parentID, appRoleID, err := parsePermissionMappingID("4/7")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, parentID)
+	assert.Equal(t, 7, appRoleID)
+
+	_, _, err = parsePermissionMappingID("4")
+	assert.EqualError(t, err, "the ID must be in the format parent_id/app_role_id")
+}
+
+func TestPermissionMappingSync(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"status":200,"data":[{"app_role_id":2,"user_groups_ids":[10],"user_ids":[1,5]}]}`))
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"status":200,"record_id":0}`))
+	}))
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	pm := permissionMapping{field: "ou_id", path: "ou", label: "OU Permission Mapping"}
+	raw := map[string]interface{}{
+		"app_role_id": 2,
+		"ou_id":       3,
+		"users":       []interface{}{map[string]interface{}{"id": 1}, map[string]interface{}{"id": 6}},
+	}
+
+	// Authoritative removes the user and group that are not configured.
+	raw["mode"] = "authoritative"
+	requests = nil
+	d := schema.TestResourceDataRaw(t, resourceOUPermissionMapping().Schema, raw)
+	assert.NoError(t, pm.sync(context.Background(), c, d, nil, nil))
+	assert.Equal(t, []string{
+		`POST /api/v3/ou/3/permission-mapping {"app_role_id":2,"user_groups_ids":[],"user_ids":[6],"post_webhook_id":null,"pre_webhook_id":null}`,
+		`DELETE /api/v3/ou/3/permission-mapping {"app_role_id":2,"user_groups_ids":[10],"user_ids":[5]}`,
+	}, requests)
+
+	// Additive only adds the missing user.
+	raw["mode"] = "additive"
+	requests = nil
+	d = schema.TestResourceDataRaw(t, resourceOUPermissionMapping().Schema, raw)
+	assert.NoError(t, pm.sync(context.Background(), c, d, nil, nil))
+	assert.Equal(t, []string{
+		`POST /api/v3/ou/3/permission-mapping {"app_role_id":2,"user_groups_ids":[],"user_ids":[6],"post_webhook_id":null,"pre_webhook_id":null}`,
+	}, requests)
+}
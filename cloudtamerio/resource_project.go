@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceProject() *schema.Resource {
 		ReadContext:   resourceProjectRead,
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceProjectRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_project", resourceProjectRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -47,6 +49,18 @@ func resourceProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"labels": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -90,32 +104,28 @@ func resourceProject() *schema.Resource {
 						"amount": {
 							Type:     schema.TypeFloat,
 							Optional: true,
-							ForceNew: true, // Not allowed to be changed, forces new item if changed.
 						},
 						"funding_order": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true, // Not allowed to be changed, forces new item if changed.
 						},
 						"funding_source_id": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							ForceNew: true, // Not allowed to be changed, forces new item if changed.
 						},
 						"start_datecode": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true, // Not allowed to be changed, forces new item if changed.
 						},
 						"end_datecode": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true, // Not allowed to be changed, forces new item if changed.
 						},
 					},
 				},
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 		},
 	}
@@ -129,6 +139,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		AutoPay:            d.Get("auto_pay").(bool),
 		DefaultAwsRegion:   d.Get("default_aws_region").(string),
 		Description:        d.Get("description").(string),
+		LabelIds:           hc.FlattenGenericIDPointer(d, "labels"),
 		Name:               d.Get("name").(string),
 		OUID:               d.Get("ou_id").(int),
 		OwnerUserIds:       hc.FlattenGenericIDPointer(d, "owner_user_ids"),
@@ -141,21 +152,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 	post.ProjectFunding = make([]hc.ProjectFundingCreate, len(d.Get("project_funding").([]interface{})))
 
 	for i, genericValue := range d.Get("project_funding").([]interface{}) {
-
-		// Cast each generic interface{} value to a map of key/value pairs
-		projectFundingMap := genericValue.(map[string]interface{})
-
-		// Unpack struct values & assign them to the POST object
-		post.ProjectFunding[i] = hc.ProjectFundingCreate{
-			Amount:          projectFundingMap["amount"].(float64),
-			FundingOrder:    projectFundingMap["funding_order"].(int),
-			FundingSourceID: projectFundingMap["funding_source_id"].(int),
-			StartDatecode:   projectFundingMap["start_datecode"].(string),
-			EndDatecode:     projectFundingMap["end_datecode"].(string),
-		}
+		post.ProjectFunding[i] = expandProjectFunding(genericValue.(map[string]interface{}))
 	}
 
-	resp, err := c.POST("/v3/project", post)
+	resp, err := c.POST(ctx, "/v3/project", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -185,8 +185,13 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	ID := d.Id()
 
 	resp := new(hc.ProjectResponse)
-	err := c.GET(fmt.Sprintf("/v3/project/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/project/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Project %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Project",
@@ -201,9 +206,22 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	data["auto_pay"] = item.AutoPay
 	data["default_aws_region"] = item.DefaultAwsRegion
 	data["description"] = item.Description
+	data["labels"] = inflateLabels(item.Labels)
 	data["name"] = item.Name
 	data["ou_id"] = item.OUID
 
+	fundingResp := new(hc.ProjectFundingListResponse)
+	err = c.GET(ctx, fmt.Sprintf("/v3/project/%s/funding", ID), fundingResp)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Project Funding",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	data["project_funding"] = flattenProjectFunding(fundingResp, d.Get("project_funding").([]interface{}))
+
 	for k, v := range data {
 		if err := d.Set(k, v); err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -245,7 +263,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			PermissionSchemeID: d.Get("permission_scheme_id").(int),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/project/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/project/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -256,6 +274,12 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
+	// Update the project funding in place.
+	diags, hasChanged = ProjectFundingChanges(ctx, c, d, diags, hasChanged)
+	if len(diags) > 0 {
+		return diags
+	}
+
 	// Determine if the owners have changed.
 	if d.HasChanges("owner_user_ids",
 		"owner_user_group_ids") {
@@ -267,7 +291,7 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			len(arrAddOwnerUserIds) > 0 ||
 			len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v1/project/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v1/project/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -282,6 +306,12 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
+	// Determine if the labels have changed.
+	diags, hasChanged = LabelChanges(ctx, c, d, diags, hasChanged, fmt.Sprintf("/v3/project/%s", ID), "Project")
+	if len(diags) > 0 {
+		return diags
+	}
+
 	if hasChanged > 0 {
 		d.Set("last_updated", time.Now().Format(time.RFC850))
 	}
@@ -294,7 +324,7 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m interf
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/project/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/project/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceProjectCloudAccessRole() *schema.Resource {
 		ReadContext:   resourceProjectCloudAccessRoleRead,
 		UpdateContext: resourceProjectCloudAccessRoleUpdate,
 		DeleteContext: resourceProjectCloudAccessRoleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceProjectCloudAccessRoleRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_project_cloud_access_role", resourceProjectCloudAccessRoleRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -166,7 +168,7 @@ func resourceProjectCloudAccessRoleCreate(ctx context.Context, d *schema.Resourc
 		WebAccess:                 d.Get("web_access").(bool),
 	}
 
-	resp, err := c.POST("/v3/project-cloud-access-role", post)
+	resp, err := c.POST(ctx, "/v3/project-cloud-access-role", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -196,8 +198,13 @@ func resourceProjectCloudAccessRoleRead(ctx context.Context, d *schema.ResourceD
 	ID := d.Id()
 
 	resp := new(hc.ProjectCloudAccessRoleResponse)
-	err := c.GET(fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] ProjectCloudAccessRole %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read ProjectCloudAccessRole",
@@ -277,7 +284,7 @@ func resourceProjectCloudAccessRoleUpdate(ctx context.Context, d *schema.Resourc
 			WebAccess:           d.Get("web_access").(bool),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -309,7 +316,7 @@ func resourceProjectCloudAccessRoleUpdate(ctx context.Context, d *schema.Resourc
 			len(arrAddAzureRoleDefinitions) > 0 ||
 			len(arrAddUserGroupIds) > 0 ||
 			len(arrAddUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), hc.ProjectCloudAccessRoleAssociationsAdd{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), hc.ProjectCloudAccessRoleAssociationsAdd{
 				AccountIds:                &arrAddAccountIds,
 				AwsIamPermissionsBoundary: arrAddAwsIamPermissionsBoundary,
 				AwsIamPolicies:            &arrAddAwsIamPolicies,
@@ -333,7 +340,7 @@ func resourceProjectCloudAccessRoleUpdate(ctx context.Context, d *schema.Resourc
 			len(arrRemoveAzureRoleDefinitions) > 0 ||
 			len(arrRemoveUserGroupIds) > 0 ||
 			len(arrRemoveUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), hc.ProjectCloudAccessRoleAssociationsRemove{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s/association", ID), hc.ProjectCloudAccessRoleAssociationsRemove{
 				AccountIds:                &arrRemoveAccountIds,
 				AwsIamPermissionsBoundary: arrRemoveAwsIamPermissionsBoundary,
 				AwsIamPolicies:            &arrRemoveAwsIamPolicies,
@@ -364,7 +371,7 @@ func resourceProjectCloudAccessRoleDelete(ctx context.Context, d *schema.Resourc
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/project-cloud-access-role/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
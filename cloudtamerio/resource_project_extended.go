@@ -0,0 +1,159 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProjectFundingChanges updates the funding of a project in place when the
+// project_funding blocks change. Funding is matched by funding_order. Only
+// funding that was in the previous project_funding blocks is removed so
+// funding managed by cloudtamerio_project_funding is left alone.
+func ProjectFundingChanges(ctx context.Context, c *hc.Client, d *schema.ResourceData, diags diag.Diagnostics, hasChanged int) (diag.Diagnostics, int) {
+	if !d.HasChange("project_funding") {
+		return diags, hasChanged
+	}
+	hasChanged++
+
+	o, _ := d.GetChange("project_funding")
+	managed := fundingOrders(o.([]interface{}))
+
+	resp := new(hc.ProjectFundingListResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/project/%s/funding", d.Id()), resp)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Project Funding",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), d.Id()),
+		})
+		return diags, hasChanged
+	}
+
+	desired := make(map[int]hc.ProjectFundingCreate)
+	for _, v := range d.Get("project_funding").([]interface{}) {
+		f := expandProjectFunding(v.(map[string]interface{}))
+		desired[f.FundingOrder] = f
+	}
+
+	// Update or remove the existing funding.
+	for _, item := range resp.Data {
+		f, ok := desired[item.FundingOrder]
+		if !ok {
+			if _, ok := managed[item.FundingOrder]; !ok {
+				continue
+			}
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/project-funding/%d", item.ID), nil)
+			if err != nil && !hc.IsNotFound(err) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to remove Project Funding",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), item.ID),
+				})
+				return diags, hasChanged
+			}
+			continue
+		}
+		delete(desired, item.FundingOrder)
+
+		if item.Amount == f.Amount &&
+			item.EndDatecode == f.EndDatecode &&
+			item.FundingSourceID == f.FundingSourceID &&
+			item.StartDatecode == f.StartDatecode {
+			continue
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/project-funding/%d", item.ID), hc.ProjectFundingUpdate{
+			Amount:          f.Amount,
+			EndDatecode:     f.EndDatecode,
+			FundingOrder:    f.FundingOrder,
+			FundingSourceID: f.FundingSourceID,
+			StartDatecode:   f.StartDatecode,
+		})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Project Funding",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), item.ID),
+			})
+			return diags, hasChanged
+		}
+	}
+
+	// Add the new funding.
+	for _, f := range desired {
+		_, err := c.POST(ctx, fmt.Sprintf("/v3/project/%s/funding", d.Id()), f)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to add Project Funding",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), f),
+			})
+			return diags, hasChanged
+		}
+	}
+
+	return diags, hasChanged
+}
+
+// expandProjectFunding converts a project_funding block to the API object.
+func expandProjectFunding(m map[string]interface{}) hc.ProjectFundingCreate {
+	return hc.ProjectFundingCreate{
+		Amount:          m["amount"].(float64),
+		FundingOrder:    m["funding_order"].(int),
+		FundingSourceID: m["funding_source_id"].(int),
+		StartDatecode:   m["start_datecode"].(string),
+		EndDatecode:     m["end_datecode"].(string),
+	}
+}
+
+// fundingOrders returns the position of each funding_order in the
+// project_funding blocks.
+func fundingOrders(blocks []interface{}) map[int]int {
+	orders := make(map[int]int)
+	for i, v := range blocks {
+		if m, ok := v.(map[string]interface{}); ok {
+			orders[m["funding_order"].(int)] = i
+		}
+	}
+	return orders
+}
+
+// flattenProjectFunding converts the funding of a project to project_funding
+// blocks. When the project already has project_funding blocks, only that
+// funding is returned and in the same order so the plan has no diff and
+// funding managed by cloudtamerio_project_funding is not picked up. Otherwise,
+// like after an import, all the funding is returned ordered by funding_order.
+func flattenProjectFunding(resp *hc.ProjectFundingListResponse, blocks []interface{}) []map[string]interface{} {
+	orders := fundingOrders(blocks)
+
+	items := make([]hc.ProjectFunding, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		if _, ok := orders[item.FundingOrder]; ok || len(orders) == 0 {
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if len(orders) > 0 {
+			return orders[items[i].FundingOrder] < orders[items[j].FundingOrder]
+		}
+		return items[i].FundingOrder < items[j].FundingOrder
+	})
+
+	arr := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		arr = append(arr, map[string]interface{}{
+			"amount":            item.Amount,
+			"end_datecode":      item.EndDatecode,
+			"funding_order":     item.FundingOrder,
+			"funding_source_id": item.FundingSourceID,
+			"start_datecode":    item.StartDatecode,
+		})
+	}
+
+	return arr
+}
@@ -0,0 +1,286 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProjectFunding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectFundingCreate,
+		ReadContext:   resourceProjectFundingRead,
+		UpdateContext: resourceProjectFundingUpdate,
+		DeleteContext: resourceProjectFundingDelete,
+		Importer:      importWithRead("cloudtamerio_project_funding", resourceProjectFundingRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"amount": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"end_datecode": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"funding_order": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"funding_source_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+			"start_datecode": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProjectFundingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	projectID := d.Get("project_id").(int)
+	post := hc.ProjectFundingCreate{
+		Amount:          d.Get("amount").(float64),
+		EndDatecode:     d.Get("end_datecode").(string),
+		FundingOrder:    d.Get("funding_order").(int),
+		FundingSourceID: d.Get("funding_source_id").(int),
+		StartDatecode:   d.Get("start_datecode").(string),
+	}
+
+	_, err := c.POST(ctx, fmt.Sprintf("/v3/project/%d/funding", projectID), post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Project Funding",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	}
+
+	// The funding is keyed by the project and the funding order because the
+	// order is unique within a project.
+	d.SetId(fmt.Sprintf("%d/%d", projectID, post.FundingOrder))
+
+	return resourceProjectFundingRead(ctx, d, m)
+}
+
+func resourceProjectFundingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	projectID, fundingOrder, err := parseProjectFundingID(ID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Project Funding",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	resp := new(hc.ProjectFundingListResponse)
+	err = c.GET(ctx, fmt.Sprintf("/v3/project/%d/funding", projectID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Project Funding %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Project Funding",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	found := false
+	data := make(map[string]interface{})
+	for _, item := range resp.Data {
+		if item.FundingOrder != fundingOrder {
+			continue
+		}
+		found = true
+		data["amount"] = item.Amount
+		data["end_datecode"] = item.EndDatecode
+		data["funding_order"] = item.FundingOrder
+		data["funding_source_id"] = item.FundingSourceID
+		data["project_id"] = projectID
+		data["start_datecode"] = item.StartDatecode
+		break
+	}
+
+	if !found {
+		log.Printf("[WARN] Project Funding %s was not found, removing from state", ID)
+		d.SetId("")
+		return diags
+	}
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Project Funding",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceProjectFundingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `funding_order` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("amount",
+		"end_datecode",
+		"funding_source_id",
+		"start_datecode") {
+		hasChanged++
+
+		fundingID, err := findProjectFundingID(ctx, c, d)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Project Funding",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+
+		req := hc.ProjectFundingUpdate{
+			Amount:          d.Get("amount").(float64),
+			EndDatecode:     d.Get("end_datecode").(string),
+			FundingOrder:    d.Get("funding_order").(int),
+			FundingSourceID: d.Get("funding_source_id").(int),
+			StartDatecode:   d.Get("start_datecode").(string),
+		}
+
+		err = c.PATCH(ctx, fmt.Sprintf("/v3/project-funding/%d", fundingID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Project Funding",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceProjectFundingRead(ctx, d, m)
+}
+
+func resourceProjectFundingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	fundingID, err := findProjectFundingID(ctx, c, d)
+	if err == nil {
+		err = c.DELETE(ctx, fmt.Sprintf("/v3/project-funding/%d", fundingID), nil)
+	}
+	if err != nil && err != errProjectFundingNotFound && !hc.IsNotFound(err) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Project Funding",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// errProjectFundingNotFound is returned when the project has no funding with
+// the funding order.
+var errProjectFundingNotFound = errors.New("the project has no funding with the funding order")
+
+// parseProjectFundingID splits an ID in the format project_id/funding_order.
+func parseProjectFundingID(ID string) (int, int, error) {
+	parts := strings.Split(ID, "/")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("the ID must be in the format project_id/funding_order")
+	}
+
+	projectID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the project ID is not a number: %v", parts[0])
+	}
+
+	fundingOrder, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("the funding order is not a number: %v", parts[1])
+	}
+
+	return projectID, fundingOrder, nil
+}
+
+// findProjectFundingID returns the ID cloudtamer.io assigned to the funding
+// because updates and deletes are not keyed by the funding order.
+func findProjectFundingID(ctx context.Context, c *hc.Client, d *schema.ResourceData) (int, error) {
+	projectID, fundingOrder, err := parseProjectFundingID(d.Id())
+	if err != nil {
+		return 0, err
+	}
+
+	resp := new(hc.ProjectFundingListResponse)
+	err = c.GET(ctx, fmt.Sprintf("/v3/project/%d/funding", projectID), resp)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, item := range resp.Data {
+		if item.FundingOrder == fundingOrder {
+			return item.ID, nil
+		}
+	}
+
+	return 0, errProjectFundingNotFound
+}
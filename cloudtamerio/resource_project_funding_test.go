@@ -0,0 +1,157 @@
+package cloudtamerio
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// projectFundingServer returns the funding of project 7 and records every
+// other request. Funding order 3 is managed by cloudtamerio_project_funding.
+func projectFundingServer(requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Write([]byte(`{"status":200,"data":[
+				{"id":11,"project_id":7,"funding_order":1,"funding_source_id":1,"amount":100,"start_datecode":"2021-01","end_datecode":"2022-01"},
+				{"id":12,"project_id":7,"funding_order":2,"funding_source_id":2,"amount":200,"start_datecode":"2021-01","end_datecode":"2022-01"},
+				{"id":13,"project_id":7,"funding_order":3,"funding_source_id":3,"amount":300,"start_datecode":"2021-01","end_datecode":"2022-01"}
+			]}`))
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		*requests = append(*requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"status":201,"record_id":14}`))
+	}))
+}
+
+func TestProjectFundingChanges(t *testing.T) {
+	var requests []string
+	ts := projectFundingServer(&requests)
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	// The project has funding orders 1 and 2 and the configuration changes
+	// the amount of order 1 and replaces order 2 with order 4.
+	state := &terraform.InstanceState{
+		ID: "7",
+		Attributes: map[string]string{
+			"project_funding.#":                   "2",
+			"project_funding.0.amount":            "100",
+			"project_funding.0.end_datecode":      "2022-01",
+			"project_funding.0.funding_order":     "1",
+			"project_funding.0.funding_source_id": "1",
+			"project_funding.0.start_datecode":    "2021-01",
+			"project_funding.1.amount":            "200",
+			"project_funding.1.end_datecode":      "2022-01",
+			"project_funding.1.funding_order":     "2",
+			"project_funding.1.funding_source_id": "2",
+			"project_funding.1.start_datecode":    "2021-01",
+		},
+	}
+	d, err := schema.InternalMap(resourceProject().Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"project_funding.0.amount":            {Old: "100", New: "150"},
+			"project_funding.1.amount":            {Old: "200", New: "400"},
+			"project_funding.1.funding_order":     {Old: "2", New: "4"},
+			"project_funding.1.funding_source_id": {Old: "2", New: "4"},
+		},
+	})
+	assert.NoError(t, err)
+
+	// Order 3 is not in the project_funding blocks so it is left alone.
+	diags, hasChanged := ProjectFundingChanges(context.Background(), c, d, nil, 0)
+	assert.Empty(t, diags)
+	assert.Equal(t, 1, hasChanged)
+	assert.Equal(t, []string{
+		`PATCH /api/v3/project-funding/11 {"amount":150,"end_datecode":"2022-01","funding_order":1,"funding_source_id":1,"start_datecode":"2021-01"}`,
+		`DELETE /api/v3/project-funding/12 `,
+		`POST /api/v3/project/7/funding {"funding_source_id":4,"amount":400,"start_datecode":"2021-01","end_datecode":"2022-01","funding_order":4}`,
+	}, requests)
+
+	// Nothing is sent when the funding is the same.
+	requests = nil
+	d, err = schema.InternalMap(resourceProject().Schema).Data(state, nil)
+	assert.NoError(t, err)
+	diags, hasChanged = ProjectFundingChanges(context.Background(), c, d, nil, 0)
+	assert.Empty(t, diags)
+	assert.Equal(t, 0, hasChanged)
+	assert.Empty(t, requests)
+}
+
+func TestFindProjectFundingID(t *testing.T) {
+	var requests []string
+	ts := projectFundingServer(&requests)
+	defer ts.Close()
+
+	c, err := hc.NewClient(ts.URL, "app_1_valid", hc.TransportConfig{})
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceProjectFunding().Schema, map[string]interface{}{})
+	d.SetId("7/2")
+	fundingID, err := findProjectFundingID(context.Background(), c, d)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, fundingID)
+
+	d.SetId("7/5")
+	_, err = findProjectFundingID(context.Background(), c, d)
+	assert.Equal(t, errProjectFundingNotFound, err)
+
+	d.SetId("7")
+	_, err = findProjectFundingID(context.Background(), c, d)
+	assert.Error(t, err)
+}
+
+func TestFlattenProjectFunding(t *testing.T) {
+	resp := &hc.ProjectFundingListResponse{
+		Data: []hc.ProjectFunding{
+			{ID: 13, FundingOrder: 3, Amount: 300},
+			{ID: 11, FundingOrder: 1, Amount: 100},
+			{ID: 12, FundingOrder: 2, Amount: 200},
+		},
+	}
+	orders := func(arr []map[string]interface{}) []int {
+		final := make([]int, 0)
+		for _, m := range arr {
+			final = append(final, m["funding_order"].(int))
+		}
+		return final
+	}
+
+	// Without project_funding blocks, like after an import, all the funding
+	// is returned by funding order.
+	assert.Equal(t, []int{1, 2, 3}, orders(flattenProjectFunding(resp, nil)))
+
+	// Otherwise only the funding in the blocks is returned in the same order.
+	blocks := []interface{}{
+		map[string]interface{}{"funding_order": 2},
+		map[string]interface{}{"funding_order": 1},
+	}
+	arr := flattenProjectFunding(resp, blocks)
+	assert.Equal(t, []int{2, 1}, orders(arr))
+	assert.Equal(t, 200.0, arr[0]["amount"])
+}
+
+func TestParseProjectFundingID(t *testing.T) {
+	projectID, fundingOrder, err := parseProjectFundingID("12/2")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, projectID)
+	assert.Equal(t, 2, fundingOrder)
+
+	_, _, err = parseProjectFundingID("12")
+	assert.EqualError(t, err, "the ID must be in the format project_id/funding_order")
+
+	_, _, err = parseProjectFundingID("a/2")
+	assert.EqualError(t, err, "the project ID is not a number: a")
+
+	_, _, err = parseProjectFundingID("12/b")
+	assert.EqualError(t, err, "the funding order is not a number: b")
+}
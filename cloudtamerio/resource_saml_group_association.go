@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceSamlGroupAssociation() *schema.Resource {
 		ReadContext:   resourceSamlGroupAssociationRead,
 		UpdateContext: resourceSamlGroupAssociationUpdate,
 		DeleteContext: resourceSamlGroupAssociationDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceSamlGroupAssociationRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_saml_group_association", resourceSamlGroupAssociationRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -76,7 +78,7 @@ func resourceSamlGroupAssociationCreate(ctx context.Context, d *schema.ResourceD
 		UserGroupID:    d.Get("user_group_id").(int),
 	}
 
-	resp, err := c.POST("/v3/idms/group-association", post)
+	resp, err := c.POST(ctx, "/v3/idms/group-association", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -106,8 +108,13 @@ func resourceSamlGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 	ID := d.Id()
 
 	resp := new(hc.GroupAssociationResponse)
-	err := c.GET(fmt.Sprintf("/v3/idms/group-association/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/idms/group-association/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] SamlGroupAssociation %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read SamlGroupAssociation",
@@ -162,7 +169,7 @@ func resourceSamlGroupAssociationUpdate(ctx context.Context, d *schema.ResourceD
 			UserGroupID:    d.Get("user_group_id").(int),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/idms/group-association/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/idms/group-association/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -185,7 +192,7 @@ func resourceSamlGroupAssociationDelete(ctx context.Context, d *schema.ResourceD
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/idms/group-association/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/idms/group-association/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceServiceControlPolicy() *schema.Resource {
 		ReadContext:   resourceServiceControlPolicyRead,
 		UpdateContext: resourceServiceControlPolicyUpdate,
 		DeleteContext: resourceServiceControlPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceServiceControlPolicyRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_service_control_policy", resourceServiceControlPolicyRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -95,7 +97,7 @@ func resourceServiceControlPolicyCreate(ctx context.Context, d *schema.ResourceD
 		Policy:            d.Get("policy").(string),
 	}
 
-	resp, err := c.POST("/v3/service-control-policy", post)
+	resp, err := c.POST(ctx, "/v3/service-control-policy", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -125,8 +127,13 @@ func resourceServiceControlPolicyRead(ctx context.Context, d *schema.ResourceDat
 	ID := d.Id()
 
 	resp := new(hc.ServiceControlPolicyResponse)
-	err := c.GET(fmt.Sprintf("/v3/service-control-policy/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/service-control-policy/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Service_control_policy %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read Service_control_policy",
@@ -185,7 +192,7 @@ func resourceServiceControlPolicyUpdate(ctx context.Context, d *schema.ResourceD
 			Policy:      d.Get("policy").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/service-control-policy/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/service-control-policy/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -205,7 +212,7 @@ func resourceServiceControlPolicyUpdate(ctx context.Context, d *schema.ResourceD
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -221,7 +228,7 @@ func resourceServiceControlPolicyUpdate(ctx context.Context, d *schema.ResourceD
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/service-control-policy/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -248,7 +255,7 @@ func resourceServiceControlPolicyDelete(ctx context.Context, d *schema.ResourceD
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/service-control-policy/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/service-control-policy/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -0,0 +1,241 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSpendPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSpendPlanCreate,
+		ReadContext:   resourceSpendPlanRead,
+		UpdateContext: resourceSpendPlanUpdate,
+		DeleteContext: resourceSpendPlanDelete,
+		Importer:      importWithRead("cloudtamerio_spend_plan", resourceSpendPlanRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entry": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amount": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"datecode": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"funding_source_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ou_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true, // Not allowed to be changed, forces new item if changed.
+				ExactlyOneOf: []string{"ou_id", "project_id"},
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true, // Not allowed to be changed, forces new item if changed.
+			},
+		},
+	}
+}
+
+func resourceSpendPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.SpendPlanCreate{
+		Entries:   expandSpendPlanEntries(d.Get("entry").([]interface{})),
+		Name:      d.Get("name").(string),
+		OUID:      hc.FlattenIntPointer(d, "ou_id"),
+		ProjectID: hc.FlattenIntPointer(d, "project_id"),
+	}
+
+	resp, err := c.POST(ctx, "/v3/spend-plan", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Spend Plan",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Spend Plan",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceSpendPlanRead(ctx, d, m)
+}
+
+func resourceSpendPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.SpendPlanResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/spend-plan/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Spend Plan %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Spend Plan",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["created_at"] = item.CreatedAt
+	data["entry"] = flattenSpendPlanEntries(item.Entries)
+	data["name"] = item.Name
+	data["ou_id"] = item.OUID
+	data["project_id"] = item.ProjectID
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Spend Plan",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceSpendPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	// Leave out fields that are not allowed to be changed like
+	// `project_id` and add `ForceNew: true` to the schema instead.
+	if d.HasChanges("entry",
+		"name") {
+		hasChanged++
+		req := hc.SpendPlanUpdate{
+			Entries: expandSpendPlanEntries(d.Get("entry").([]interface{})),
+			Name:    d.Get("name").(string),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/spend-plan/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Spend Plan",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceSpendPlanRead(ctx, d, m)
+}
+
+func resourceSpendPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/spend-plan/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Spend Plan",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// expandSpendPlanEntries converts the entry blocks to the API objects.
+func expandSpendPlanEntries(items []interface{}) []hc.SpendPlanEntry {
+	arr := make([]hc.SpendPlanEntry, 0, len(items))
+	for _, item := range items {
+		v := item.(map[string]interface{})
+		arr = append(arr, hc.SpendPlanEntry{
+			Amount:          v["amount"].(float64),
+			Datecode:        v["datecode"].(string),
+			FundingSourceID: v["funding_source_id"].(int),
+		})
+	}
+
+	return arr
+}
+
+// flattenSpendPlanEntries converts the entries of a spend plan to entry blocks.
+func flattenSpendPlanEntries(items []hc.SpendPlanEntry) []map[string]interface{} {
+	arr := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		arr = append(arr, map[string]interface{}{
+			"amount":            item.Amount,
+			"datecode":          item.Datecode,
+			"funding_source_id": item.FundingSourceID,
+		})
+	}
+
+	return arr
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
 	"time"
 
@@ -18,11 +19,12 @@ func resourceUserGroup() *schema.Resource {
 		ReadContext:   resourceUserGroupRead,
 		UpdateContext: resourceUserGroupUpdate,
 		DeleteContext: resourceUserGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				resourceUserGroupRead(ctx, d, m)
-				return []*schema.ResourceData{d}, nil
-			},
+		Importer:      importWithRead("cloudtamerio_user_group", resourceUserGroupRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			// Notice there is no 'id' field specified because it will be created.
@@ -104,7 +106,7 @@ func resourceUserGroupCreate(ctx context.Context, d *schema.ResourceData, m inte
 		UserIds:           hc.FlattenGenericIDPointer(d, "users"),
 	}
 
-	resp, err := c.POST("/v3/user-group", post)
+	resp, err := c.POST(ctx, "/v3/user-group", post)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -134,8 +136,13 @@ func resourceUserGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 	ID := d.Id()
 
 	resp := new(hc.UGroupResponse)
-	err := c.GET(fmt.Sprintf("/v3/user-group/%s", ID), resp)
+	err := c.GET(ctx, fmt.Sprintf("/v3/user-group/%s", ID), resp)
 	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] UserGroup %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to read UserGroup",
@@ -196,7 +203,7 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			Name:        d.Get("name").(string),
 		}
 
-		err := c.PATCH(fmt.Sprintf("/v3/user-group/%s", ID), req)
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/user-group/%s", ID), req)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -213,7 +220,7 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		arrAddUserIds, arrRemoveUserIds, _, _ := hc.AssociationChanged(d, "users")
 
 		if len(arrAddUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/user-group/%s/user", ID), arrAddUserIds)
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/user-group/%s/user", ID), arrAddUserIds)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -225,7 +232,7 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		}
 
 		if len(arrRemoveUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/user-group/%s/user", ID), arrRemoveUserIds)
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/user-group/%s/user", ID), arrRemoveUserIds)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -246,7 +253,7 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrAddOwnerUserGroupIds) > 0 ||
 			len(arrAddOwnerUserIds) > 0 {
-			_, err := c.POST(fmt.Sprintf("/v3/user-group/%s/owner", ID), hc.ChangeOwners{
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/user-group/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
 				OwnerUserIds:      &arrAddOwnerUserIds,
 			})
@@ -262,7 +269,7 @@ func resourceUserGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 		if len(arrRemoveOwnerUserGroupIds) > 0 ||
 			len(arrRemoveOwnerUserIds) > 0 {
-			err := c.DELETE(fmt.Sprintf("/v3/user-group/%s/owner", ID), hc.ChangeOwners{
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/user-group/%s/owner", ID), hc.ChangeOwners{
 				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
 				OwnerUserIds:      &arrRemoveOwnerUserIds,
 			})
@@ -289,7 +296,7 @@ func resourceUserGroupDelete(ctx context.Context, d *schema.ResourceData, m inte
 	c := m.(*hc.Client)
 	ID := d.Id()
 
-	err := c.DELETE(fmt.Sprintf("/v3/user-group/%s", ID), nil)
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/user-group/%s", ID), nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
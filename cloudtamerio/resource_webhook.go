@@ -0,0 +1,316 @@
+package cloudtamerio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		Importer:      importWithRead("cloudtamerio_webhook", resourceWebhookRead),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			// Notice there is no 'id' field specified because it will be created.
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"callout_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner_user_groups": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"owner_users": {
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+				Type:     schema.TypeList,
+				Optional: true,
+			},
+			"request_body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"request_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"request_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}, false),
+			},
+			"skip_ssl_verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 900),
+			},
+		},
+	}
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+
+	post := hc.WebhookCreate{
+		CalloutURL:        d.Get("callout_url").(string),
+		Description:       d.Get("description").(string),
+		Name:              d.Get("name").(string),
+		OwnerUserGroupIds: hc.FlattenGenericIDPointer(d, "owner_user_groups"),
+		OwnerUserIds:      hc.FlattenGenericIDPointer(d, "owner_users"),
+		RequestBody:       d.Get("request_body").(string),
+		RequestHeaders:    expandStringMap(d.Get("request_headers").(map[string]interface{})),
+		RequestType:       d.Get("request_type").(string),
+		SkipSSLVerify:     d.Get("skip_ssl_verify").(bool),
+		TimeoutInSeconds:  d.Get("timeout_in_seconds").(int),
+	}
+
+	resp, err := c.POST(ctx, "/v3/webhook", post)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Webhook",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), post.Name),
+		})
+		return diags
+	} else if resp.RecordID == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Webhook",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", errors.New("received item ID of 0"), post.Name),
+		})
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resp.RecordID))
+
+	return resourceWebhookRead(ctx, d, m)
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	resp := new(hc.WebhookResponse)
+	err := c.GET(ctx, fmt.Sprintf("/v3/webhook/%s", ID), resp)
+	if err != nil {
+		if hc.IsNotFound(err) {
+			log.Printf("[WARN] Webhook %s was not found, removing from state", ID)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to read Webhook",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+	item := resp.Data
+
+	data := make(map[string]interface{})
+	data["callout_url"] = item.Webhook.CalloutURL
+	data["created_at"] = item.Webhook.CreatedAt
+	data["description"] = item.Webhook.Description
+	data["name"] = item.Webhook.Name
+	if hc.InflateObjectWithID(item.OwnerUserGroups) != nil {
+		data["owner_user_groups"] = hc.InflateObjectWithID(item.OwnerUserGroups)
+	}
+	if hc.InflateObjectWithID(item.OwnerUsers) != nil {
+		data["owner_users"] = hc.InflateObjectWithID(item.OwnerUsers)
+	}
+	data["request_body"] = item.Webhook.RequestBody
+	data["request_headers"] = item.Webhook.RequestHeaders
+	data["request_type"] = item.Webhook.RequestType
+	data["skip_ssl_verify"] = item.Webhook.SkipSSLVerify
+	data["timeout_in_seconds"] = item.Webhook.TimeoutInSeconds
+
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to read and set Webhook",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	hasChanged := 0
+
+	// Determine if the attributes that are updatable are changed.
+	if d.HasChanges("callout_url",
+		"description",
+		"name",
+		"request_body",
+		"request_headers",
+		"request_type",
+		"skip_ssl_verify",
+		"timeout_in_seconds") {
+		hasChanged++
+		req := hc.WebhookUpdate{
+			CalloutURL:       d.Get("callout_url").(string),
+			Description:      d.Get("description").(string),
+			Name:             d.Get("name").(string),
+			RequestBody:      d.Get("request_body").(string),
+			RequestHeaders:   expandStringMap(d.Get("request_headers").(map[string]interface{})),
+			RequestType:      d.Get("request_type").(string),
+			SkipSSLVerify:    d.Get("skip_ssl_verify").(bool),
+			TimeoutInSeconds: d.Get("timeout_in_seconds").(int),
+		}
+
+		err := c.PATCH(ctx, fmt.Sprintf("/v3/webhook/%s", ID), req)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Unable to update Webhook",
+				Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+			})
+			return diags
+		}
+	}
+
+	// Determine if the owners have changed.
+	if d.HasChanges("owner_user_groups",
+		"owner_users") {
+		hasChanged++
+		arrAddOwnerUserGroupIds, arrRemoveOwnerUserGroupIds, _, _ := hc.AssociationChanged(d, "owner_user_groups")
+		arrAddOwnerUserIds, arrRemoveOwnerUserIds, _, _ := hc.AssociationChanged(d, "owner_users")
+
+		if len(arrAddOwnerUserGroupIds) > 0 ||
+			len(arrAddOwnerUserIds) > 0 {
+			_, err := c.POST(ctx, fmt.Sprintf("/v3/webhook/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrAddOwnerUserGroupIds,
+				OwnerUserIds:      &arrAddOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to add owners on Webhook",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+
+		if len(arrRemoveOwnerUserGroupIds) > 0 ||
+			len(arrRemoveOwnerUserIds) > 0 {
+			err := c.DELETE(ctx, fmt.Sprintf("/v3/webhook/%s/owner", ID), hc.ChangeOwners{
+				OwnerUserGroupIds: &arrRemoveOwnerUserGroupIds,
+				OwnerUserIds:      &arrRemoveOwnerUserIds,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Unable to remove owners on Webhook",
+					Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+				})
+				return diags
+			}
+		}
+	}
+
+	if hasChanged > 0 {
+		d.Set("last_updated", time.Now().Format(time.RFC850))
+	}
+
+	return resourceWebhookRead(ctx, d, m)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*hc.Client)
+	ID := d.Id()
+
+	err := c.DELETE(ctx, fmt.Sprintf("/v3/webhook/%s", ID), nil)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to delete Webhook",
+			Detail:   fmt.Sprintf("Error: %v\nItem: %v", err.Error(), ID),
+		})
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// expandStringMap converts a map from the schema to a map of strings.
+func expandStringMap(m map[string]interface{}) map[string]string {
+	arr := make(map[string]string)
+	for k, v := range m {
+		arr[k] = v.(string)
+	}
+
+	return arr
+}
@@ -0,0 +1,170 @@
+package cloudtamerio
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	hc "github.com/cloudtamer-io/terraform-provider-cloudtamerio/cloudtamerio/internal/ctclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	resourceTypeWebhook        = "cloudtamerio_webhook"
+	resourceNameWebhook        = "wh1"
+	dataSourceLocalNameWebhook = "webhooks"
+)
+
+var accTestWebhook = hc.WebhookCreate{
+	CalloutURL:        "https://example.com/hook",
+	Description:       "sample webhook for terraform acceptance test",
+	Name:              "Terraform AccTest Webhook",
+	RequestBody:       `{"account":"{{CT::Account::ID}}"}`,
+	RequestType:       "POST",
+	TimeoutInSeconds:  30,
+	OwnerUserIds:      &ownerUserIds,
+	OwnerUserGroupIds: &ownerUserGroupIDs,
+}
+
+func TestAccResourceWebhook(t *testing.T) {
+	wh := accTestWebhook
+
+	// Create
+	create := resource.TestStep{
+		Config: testAccWebhookGenerateResourceDeclaration(&wh),
+		Check:  resource.ComposeTestCheckFunc(testAccWebhookCheckResource(&wh)...),
+	}
+
+	// Update
+	wh.Name = "(Updated) Terraform AccTest Webhook"
+	wh.RequestType = "PUT"
+	wh.TimeoutInSeconds = 60
+	update := resource.TestStep{
+		Config: testAccWebhookGenerateResourceDeclaration(&wh),
+		Check:  resource.ComposeTestCheckFunc(testAccWebhookCheckResource(&wh)...),
+	}
+
+	// Import
+	importState := resource.TestStep{
+		ResourceName:            resourceTypeWebhook + "." + resourceNameWebhook,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"last_updated"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWebhookCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			create,
+			update,
+			importState,
+		},
+	})
+}
+
+func TestAccDataSourceWebhook(t *testing.T) {
+	resourceDeclaration := testAccWebhookGenerateResourceDeclaration(&accTestWebhook)
+	dataSourceDeclarationFilter := hc.TestAccOUGenerateDataSourceDeclarationFilter(resourceTypeWebhook, dataSourceLocalNameWebhook, accTestWebhook.Name)
+	dataSourceName := fmt.Sprintf("data.%v.%v", resourceTypeWebhook, dataSourceLocalNameWebhook)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWebhookCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDeclaration + "\n" + dataSourceDeclarationFilter,
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "filter.0.values.0", accTestWebhook.Name),
+			},
+		},
+	})
+}
+
+// testAccWebhookCheckResource returns a slice of functions that validate the test resource's fields
+func testAccWebhookCheckResource(wh *hc.WebhookCreate) (funcs []resource.TestCheckFunc) {
+	name := resourceTypeWebhook + "." + resourceNameWebhook
+
+	funcs = []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(name, "callout_url", wh.CalloutURL),
+		resource.TestCheckResourceAttr(name, "description", wh.Description),
+		resource.TestCheckResourceAttr(name, "name", wh.Name),
+		resource.TestCheckResourceAttr(name, "request_body", wh.RequestBody),
+		resource.TestCheckResourceAttr(name, "request_type", wh.RequestType),
+		resource.TestCheckResourceAttr(name, "timeout_in_seconds", fmt.Sprint(wh.TimeoutInSeconds)),
+	}
+
+	funcs = append(funcs, hc.GenerateAccTestChecksForResourceOwners(
+		resourceTypeWebhook,
+		resourceNameWebhook,
+		wh.OwnerUserIds,
+		wh.OwnerUserGroupIds,
+	)...)
+
+	return
+}
+
+// testAccWebhookGenerateResourceDeclaration generates a resource declaration string (a la main.tf)
+func testAccWebhookGenerateResourceDeclaration(wh *hc.WebhookCreate) string {
+	if wh == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+		resource "%v" "%v" {
+			callout_url        = "%v"
+			description        = "%v"
+			name               = "%v"
+			request_body       = %q
+			request_type       = "%v"
+			timeout_in_seconds = %v
+			%v
+		}`,
+		resourceTypeWebhook, resourceNameWebhook,
+		wh.CalloutURL,
+		wh.Description,
+		wh.Name,
+		wh.RequestBody,
+		wh.RequestType,
+		wh.TimeoutInSeconds,
+		hc.GenerateOwnerClausesForResourceTest(wh.OwnerUserIds, wh.OwnerUserGroupIds),
+	)
+}
+
+// testAccWebhookCheckResourceDestroy verifies the resource has been destroyed
+func testAccWebhookCheckResourceDestroy(s *terraform.State) error {
+	// retrieve the connection established in Provider configuration
+	meta := testAccProvider.Meta()
+	if meta == nil {
+		return nil
+	}
+
+	c := meta.(*hc.Client)
+
+	// loop through the resources in state, verifying each resource is destroyed
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceTypeWebhook {
+			continue
+		}
+
+		resp := new(hc.WebhookResponse)
+		err := c.GET(context.Background(), fmt.Sprintf("/v3/webhook/%s", rs.Primary.ID), resp)
+		if err == nil {
+			if fmt.Sprint(resp.Data.Webhook.ID) == rs.Primary.ID {
+				return fmt.Errorf("Webhook (%s) still exists.", rs.Primary.ID)
+			}
+
+			return nil
+		}
+
+		// If the error is equivalent to 404 not found, the resource is destroyed.
+		// Otherwise, return the error
+		if !hc.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package cloudtamerio
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// suppressEquivalentTime ignores a difference between two times that are the
+// same instant, like an expiry returned in UTC that was set in another zone.
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return o.Equal(n)
+}
@@ -0,0 +1,20 @@
+package cloudtamerio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressEquivalentTime(t *testing.T) {
+	// Same instant in another zone or with fractional seconds
+	assert.True(t, suppressEquivalentTime("expires_at", "2030-01-01T00:00:00Z", "2029-12-31T19:00:00-05:00", nil))
+	assert.True(t, suppressEquivalentTime("expires_at", "2030-01-01T00:00:00.000Z", "2030-01-01T00:00:00Z", nil))
+
+	// Different instant
+	assert.False(t, suppressEquivalentTime("expires_at", "2030-01-01T00:00:00Z", "2030-01-02T00:00:00Z", nil))
+
+	// Not a time
+	assert.False(t, suppressEquivalentTime("expires_at", "", "2030-01-01T00:00:00Z", nil))
+	assert.False(t, suppressEquivalentTime("expires_at", "2030-01-01", "2030-01-01", nil))
+}